@@ -0,0 +1,10 @@
+{{- define "storageclass.topology" -}}
+volumeBindingMode: {{ .Values.volumeBindingMode }}
+{{- if .Values.allowedZones }}
+allowedTopologies:
+- matchLabelExpressions:
+  - key: topology.gke.io/zone
+    values:
+{{ toYaml .Values.allowedZones | indent 4 }}
+{{- end }}
+{{- end -}}
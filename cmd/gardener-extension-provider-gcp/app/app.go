@@ -41,6 +41,7 @@ import (
 	gcpworker "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 	gcpseedprovider "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/seedprovider"
 )
 
@@ -64,6 +65,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		backupBucketCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		backupBucketResyncOpts = &gcpcmd.ResyncOptions{}
 
 		// options for the backupentry controller
 		backupEntryCtrlOpts = &controllercmd.ControllerOptions{
@@ -74,6 +76,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		bastionCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		bastionResyncOpts = &gcpcmd.ResyncOptions{}
 
 		// options for the health care controller
 		healthCheckCtrlOpts = &controllercmd.ControllerOptions{
@@ -91,22 +94,26 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		controlPlaneCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		controlPlaneResyncOpts = &gcpcmd.ResyncOptions{}
 
 		// options for the dnsrecord controller
 		dnsRecordCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		dnsRecordResyncOpts = &gcpcmd.ResyncOptions{}
 
 		// options for the infrastructure controller
 		infraCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
-		reconcileOpts = &controllercmd.ReconcilerOptions{}
+		infraResyncOpts = &gcpcmd.ResyncOptions{}
+		reconcileOpts   = &controllercmd.ReconcilerOptions{}
 
 		// options for the worker controller
 		workerCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		workerResyncOpts = &gcpcmd.ResyncOptions{}
 
 		// options for the webhook server
 		webhookServerOptions = &webhookcmd.ServerOptions{
@@ -128,12 +135,18 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			restOpts,
 			mgrOpts,
 			controllercmd.PrefixOption("backupbucket-", backupBucketCtrlOpts),
+			controllercmd.PrefixOption("backupbucket-", backupBucketResyncOpts),
 			controllercmd.PrefixOption("backupentry-", backupEntryCtrlOpts),
 			controllercmd.PrefixOption("bastion-", bastionCtrlOpts),
+			controllercmd.PrefixOption("bastion-", bastionResyncOpts),
 			controllercmd.PrefixOption("controlplane-", controlPlaneCtrlOpts),
+			controllercmd.PrefixOption("controlplane-", controlPlaneResyncOpts),
 			controllercmd.PrefixOption("dnsrecord-", dnsRecordCtrlOpts),
+			controllercmd.PrefixOption("dnsrecord-", dnsRecordResyncOpts),
 			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
+			controllercmd.PrefixOption("infrastructure-", infraResyncOpts),
 			controllercmd.PrefixOption("worker-", workerCtrlOpts),
+			controllercmd.PrefixOption("worker-", workerResyncOpts),
 			controllercmd.PrefixOption("healthcheck-", healthCheckCtrlOpts),
 			controllercmd.PrefixOption("heartbeat-", heartbeatCtrlOpts),
 			configFileOpts,
@@ -214,15 +227,30 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 
 			log.Info("Adding controllers to manager")
 			configFileOpts.Completed().ApplyETCDStorage(&gcpseedprovider.DefaultAddOptions.ETCDStorage)
+			configFileOpts.Completed().ApplyPodSecurity(&gcpcontrolplane.DefaultAddOptions.PodSecurity)
+			configFileOpts.Completed().ApplyBastion(&gcpbastion.DefaultAddOptions.Config)
+			configFileOpts.Completed().ApplyAPIEndpoints(&gcpclient.DefaultAPIEndpoints)
+			configFileOpts.Completed().ApplyComputeRateLimits(&gcpclient.DefaultComputeRateLimits)
+			configFileOpts.Completed().ApplyComputeOperations(&gcpclient.DefaultComputeOperations)
+			var caBundle string
+			configFileOpts.Completed().ApplyCABundle(&caBundle)
+			if err := gcpclient.SetCABundle([]byte(caBundle)); err != nil {
+				return fmt.Errorf("could not configure CA bundle of GCP clients: %w", err)
+			}
 			configFileOpts.Completed().ApplyHealthCheckConfig(&healthcheck.DefaultAddOptions.HealthCheckConfig)
 			healthCheckCtrlOpts.Completed().Apply(&healthcheck.DefaultAddOptions.Controller)
 			heartbeatCtrlOpts.Completed().Apply(&heartbeat.DefaultAddOptions)
 			backupBucketCtrlOpts.Completed().Apply(&gcpbackupbucket.DefaultAddOptions.Controller)
+			backupBucketResyncOpts.Completed().Apply(&gcpbackupbucket.DefaultAddOptions.ResyncPeriod)
 			backupEntryCtrlOpts.Completed().Apply(&gcpbackupentry.DefaultAddOptions.Controller)
 			bastionCtrlOpts.Completed().Apply(&gcpbastion.DefaultAddOptions.Controller)
+			bastionResyncOpts.Completed().Apply(&gcpbastion.DefaultAddOptions.ResyncPeriod)
 			controlPlaneCtrlOpts.Completed().Apply(&gcpcontrolplane.DefaultAddOptions.Controller)
+			controlPlaneResyncOpts.Completed().Apply(&gcpcontrolplane.DefaultAddOptions.ResyncPeriod)
 			dnsRecordCtrlOpts.Completed().Apply(&gcpdnsrecord.DefaultAddOptions.Controller)
+			dnsRecordResyncOpts.Completed().Apply(&gcpdnsrecord.DefaultAddOptions.ResyncPeriod)
 			infraCtrlOpts.Completed().Apply(&gcpinfrastructure.DefaultAddOptions.Controller)
+			infraResyncOpts.Completed().Apply(&gcpinfrastructure.DefaultAddOptions.ResyncPeriod)
 			reconcileOpts.Completed().Apply(&gcpinfrastructure.DefaultAddOptions.IgnoreOperationAnnotation, &gcpinfrastructure.DefaultAddOptions.ExtensionClass)
 			reconcileOpts.Completed().Apply(&gcpcontrolplane.DefaultAddOptions.IgnoreOperationAnnotation, &gcpcontrolplane.DefaultAddOptions.ExtensionClass)
 			reconcileOpts.Completed().Apply(&gcpworker.DefaultAddOptions.IgnoreOperationAnnotation, &gcpworker.DefaultAddOptions.ExtensionClass)
@@ -230,7 +258,9 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			reconcileOpts.Completed().Apply(&gcpdnsrecord.DefaultAddOptions.IgnoreOperationAnnotation, &gcpdnsrecord.DefaultAddOptions.ExtensionClass)
 			reconcileOpts.Completed().Apply(&gcpbackupbucket.DefaultAddOptions.IgnoreOperationAnnotation, &gcpbackupbucket.DefaultAddOptions.ExtensionClass)
 			workerCtrlOpts.Completed().Apply(&gcpworker.DefaultAddOptions.Controller)
+			workerResyncOpts.Completed().Apply(&gcpworker.DefaultAddOptions.ResyncPeriod)
 			gcpworker.DefaultAddOptions.GardenCluster = gardenCluster
+			gcpinfrastructure.DefaultAddOptions.GardenCluster = gardenCluster
 
 			shootWebhookConfig, err := webhookOptions.Completed().AddToManager(ctx, mgr, nil)
 			if err != nil {
@@ -14,7 +14,9 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/gardener"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -25,10 +27,17 @@ import (
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	gcpvalidation "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
+// NewComputeClient creates the compute client which is used to check the machine types of worker pools. Exposed for
+// testing.
+var NewComputeClient = gcpclient.NewComputeClient
+
 type shoot struct {
 	client         client.Client
+	apiReader      client.Reader
 	decoder        runtime.Decoder
 	lenientDecoder runtime.Decoder
 }
@@ -37,6 +46,7 @@ type shoot struct {
 func NewShootValidator(mgr manager.Manager) extensionswebhook.Validator {
 	return &shoot{
 		client:         mgr.GetClient(),
+		apiReader:      mgr.GetAPIReader(),
 		decoder:        serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
 		lenientDecoder: serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
 	}
@@ -121,10 +131,16 @@ func (s *shoot) validateContext(valContext *validationContext) field.ErrorList {
 	if valContext.shoot.Spec.Networking != nil {
 		allErrors = append(allErrors, gcpvalidation.ValidateNetworking(valContext.shoot.Spec.Networking, networkPath, k8sVersion)...)
 		allErrors = append(allErrors, gcpvalidation.ValidateInfrastructureConfig(valContext.infrastructureConfig, valContext.shoot.Spec.Networking.Nodes, valContext.shoot.Spec.Networking.Pods, valContext.shoot.Spec.Networking.Services, infrastructureConfigPath)...)
+		allErrors = append(allErrors, gcpvalidation.ValidateNodeIPAMMode(valContext.controlPlaneConfig, valContext.shoot.Spec.Networking, controlPlaneConfigPath.Child("cloudControllerManager", "nodeIPAMMode"))...)
 	}
 
 	allErrors = append(allErrors, gcpvalidation.ValidateWorkers(valContext.shoot.Spec.Provider.Workers, workersPath)...)
 	allErrors = append(allErrors, gcpvalidation.ValidateControlPlaneConfig(valContext.controlPlaneConfig, allowedZones, workersZones(valContext.shoot.Spec.Provider.Workers), valContext.shoot.Spec.Kubernetes.Version, controlPlaneConfigPath)...)
+	// The technical ID is not yet set when the shoot is created, hence the names of the storage pools are only checked
+	// once it is known.
+	if technicalID := valContext.shoot.Status.TechnicalID; technicalID != "" {
+		allErrors = append(allErrors, gcpvalidation.ValidateStoragePoolNames(valContext.controlPlaneConfig, technicalID, controlPlaneConfigPath.Child("storage", "storagePools"))...)
+	}
 
 	// WorkerConfig
 	for i, worker := range valContext.shoot.Spec.Provider.Workers {
@@ -177,9 +193,105 @@ func (s *shoot) validateUpdate(ctx context.Context, oldShoot, currentShoot *core
 	allErrors = append(allErrors, gcpvalidation.ValidateWorkersUpdate(oldValContext.shoot.Spec.Provider.Workers, currentValContext.shoot.Spec.Provider.Workers, workersPath)...)
 	allErrors = append(allErrors, s.validateContext(currentValContext)...)
 
+	// The GCP APIs are only called for otherwise valid shoots.
+	if len(allErrors) == 0 {
+		machineTypeErrors, err := s.validateMachineTypeUpdates(ctx, oldShoot, currentShoot)
+		if err != nil {
+			return err
+		}
+		allErrors = append(allErrors, machineTypeErrors...)
+	}
+
 	return allErrors.ToAggregate()
 }
 
+// validateMachineTypeUpdates checks that the new machine types of worker pools hosting system components are available
+// in all zones of the pools. Otherwise, the machines hosting the system components would be replaced by machines which
+// cannot be provisioned.
+func (s *shoot) validateMachineTypeUpdates(ctx context.Context, oldShoot, shoot *core.Shoot) (field.ErrorList, error) {
+	allErrs := field.ErrorList{}
+
+	oldMachineTypes := make(map[string]string, len(oldShoot.Spec.Provider.Workers))
+	for _, worker := range oldShoot.Spec.Provider.Workers {
+		oldMachineTypes[worker.Name] = worker.Machine.Type
+	}
+
+	var computeClient gcpclient.ComputeClient
+	for i, worker := range shoot.Spec.Provider.Workers {
+		oldMachineType, ok := oldMachineTypes[worker.Name]
+		if !ok || oldMachineType == worker.Machine.Type || !gardencorehelper.SystemComponentsAllowed(&worker) {
+			continue
+		}
+
+		if computeClient == nil {
+			credentialsConfig, err := s.credentialsConfig(ctx, shoot)
+			if err != nil {
+				return nil, fmt.Errorf("could not read credentials of shoot: %w", err)
+			}
+			// Workload identity tokens cannot be requested here, hence the check is left to the worker controller.
+			if credentialsConfig == nil {
+				return allErrs, nil
+			}
+			computeClient, err = NewComputeClient(ctx, credentialsConfig)
+			if err != nil {
+				return nil, fmt.Errorf("could not create compute client: %w", err)
+			}
+		}
+
+		for _, zone := range worker.Zones {
+			machineType, err := computeClient.GetMachineType(ctx, zone, worker.Machine.Type)
+			if err != nil {
+				return nil, fmt.Errorf("could not get machine type %q in zone %q: %w", worker.Machine.Type, zone, err)
+			}
+			if machineType == nil {
+				allErrs = append(allErrs, field.Invalid(workersPath.Index(i).Child("machine", "type"), worker.Machine.Type, fmt.Sprintf("is not available in zone %q", zone)))
+			}
+		}
+	}
+
+	return allErrs, nil
+}
+
+// credentialsConfig returns the credentials config of the secret which is referenced by the binding of the given shoot.
+// It returns nil if the shoot does not use a secret with service account credentials.
+func (s *shoot) credentialsConfig(ctx context.Context, shoot *core.Shoot) (*gcp.CredentialsConfig, error) {
+	var secretKey client.ObjectKey
+	switch {
+	case shoot.Spec.SecretBindingName != nil:
+		secretBinding := &gardencorev1beta1.SecretBinding{}
+		if err := s.apiReader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.SecretBindingName}, secretBinding); err != nil {
+			return nil, err
+		}
+		secretKey = client.ObjectKey{Namespace: secretBinding.SecretRef.Namespace, Name: secretBinding.SecretRef.Name}
+	case shoot.Spec.CredentialsBindingName != nil:
+		credentialsBinding := &securityv1alpha1.CredentialsBinding{}
+		if err := s.apiReader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.CredentialsBindingName}, credentialsBinding); err != nil {
+			return nil, err
+		}
+		if credentialsBinding.CredentialsRef.APIVersion != corev1.SchemeGroupVersion.String() || credentialsBinding.CredentialsRef.Kind != "Secret" {
+			return nil, nil
+		}
+		secretKey = client.ObjectKey{Namespace: credentialsBinding.CredentialsRef.Namespace, Name: credentialsBinding.CredentialsRef.Name}
+	default:
+		return nil, nil
+	}
+
+	// Explicitly use the client.Reader to prevent controller-runtime to start Informer for Secrets under the hood.
+	secret := &corev1.Secret{}
+	if err := s.apiReader.Get(ctx, secretKey, secret); err != nil {
+		return nil, err
+	}
+
+	credentialsConfig, err := gcp.GetCredentialsConfigFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	if credentialsConfig.Type != gcp.ServiceAccountCredentialType {
+		return nil, nil
+	}
+	return credentialsConfig, nil
+}
+
 func newValidationContext(ctx context.Context, decoder runtime.Decoder, c client.Client, shoot *core.Shoot) (*validationContext, error) {
 	if shoot.Spec.Provider.InfrastructureConfig == nil {
 		return nil, field.Required(infrastructureConfigPath, "infrastructureConfig must be set for GCP shoots")
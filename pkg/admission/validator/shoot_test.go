@@ -11,12 +11,16 @@ import (
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	testutils "github.com/gardener/gardener/pkg/utils/test"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -26,6 +30,9 @@ import (
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/validator"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	apisgcpv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
 )
 
 var _ = Describe("Shoot validator", func() {
@@ -37,6 +44,7 @@ var _ = Describe("Shoot validator", func() {
 
 			ctrl         *gomock.Controller
 			c            *mockclient.MockClient
+			apiReader    *mockclient.MockReader
 			mgr          *mockmanager.MockManager
 			cloudProfile *gardencorev1beta1.CloudProfile
 			shoot        *core.Shoot
@@ -53,10 +61,12 @@ var _ = Describe("Shoot validator", func() {
 			Expect(gardencorev1beta1.AddToScheme(scheme)).To(Succeed())
 
 			c = mockclient.NewMockClient(ctrl)
+			apiReader = mockclient.NewMockReader(ctrl)
 
 			mgr = mockmanager.NewMockManager(ctrl)
 			mgr.EXPECT().GetScheme().Return(scheme).Times(2)
 			mgr.EXPECT().GetClient().Return(c)
+			mgr.EXPECT().GetAPIReader().Return(apiReader)
 			shootValidator = validator.NewShootValidator(mgr)
 
 			cloudProfile = &gardencorev1beta1.CloudProfile{
@@ -183,6 +193,117 @@ var _ = Describe("Shoot validator", func() {
 					),
 				)
 			})
+
+			Context("storage pools", func() {
+				BeforeEach(func() {
+					shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{
+						Raw: encode(&apisgcpv1alpha1.ControlPlaneConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apisgcpv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "ControlPlaneConfig",
+							},
+							Zone: "zone1",
+							Storage: &apisgcpv1alpha1.Storage{
+								StoragePools: []apisgcpv1alpha1.StoragePool{{
+									Name:                  "pool-with-a-long-name-abcdefghij",
+									Zone:                  "zone1",
+									Type:                  "hyperdisk-throughput",
+									ProvisionedCapacity:   10240,
+									ProvisionedThroughput: 1024,
+								}},
+							},
+						}),
+					}
+				})
+
+				It("should forbid storage pool names which exceed the maximum length together with the technical ID", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+
+					shoot.Status.TechnicalID = "shoot--dev--foo-with-a-long-name"
+
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).To(
+						ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeTooLong),
+								"Field": Equal("spec.provider.controlPlaneConfig.storage.storagePools[0].name"),
+							})),
+						),
+					)
+				})
+
+				It("should not check the storage pool names if the technical ID is not yet set", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+			})
+
+			Context("machine type change", func() {
+				var (
+					oldShoot      *core.Shoot
+					computeClient *mockgcpclient.MockComputeClient
+				)
+
+				BeforeEach(func() {
+					shoot.Spec.SecretBindingName = ptr.To("secret-binding")
+					shoot.Spec.Provider.Workers[0].Machine.Type = "n1-standard-2"
+					oldShoot = shoot.DeepCopy()
+					shoot.Spec.Provider.Workers[0].Machine.Type = "n2-standard-4"
+
+					computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+					DeferCleanup(testutils.WithVar(&validator.NewComputeClient, func(_ context.Context, credentialsConfig *gcp.CredentialsConfig) (gcpclient.ComputeClient, error) {
+						Expect(credentialsConfig.ProjectID).To(Equal("project"))
+						return computeClient, nil
+					}))
+
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+				})
+
+				expectCredentials := func() {
+					apiReader.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret-binding"}, gomock.AssignableToTypeOf(&gardencorev1beta1.SecretBinding{})).
+						SetArg(2, gardencorev1beta1.SecretBinding{SecretRef: corev1.SecretReference{Namespace: namespace, Name: "secret"}})
+					apiReader.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret"}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+						SetArg(2, corev1.Secret{Data: map[string][]byte{gcp.ServiceAccountJSONField: []byte(`{"type":"service_account","project_id":"project"}`)}})
+				}
+
+				It("should allow the change if the machine type is available in all zones", func() {
+					expectCredentials()
+					computeClient.EXPECT().GetMachineType(ctx, "zone1", "n2-standard-4").Return(&compute.MachineType{}, nil)
+
+					Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(Succeed())
+				})
+
+				It("should forbid the change if the machine type is not available in a zone", func() {
+					expectCredentials()
+					computeClient.EXPECT().GetMachineType(ctx, "zone1", "n2-standard-4").Return(nil, nil)
+
+					Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.provider.workers[0].machine.type"),
+						})),
+					))
+				})
+
+				It("should not check worker pools which do not host system components", func() {
+					shoot.Spec.Provider.Workers[0].SystemComponents = &core.WorkerSystemComponents{Allow: false}
+					oldShoot.Spec.Provider.Workers[0].SystemComponents = &core.WorkerSystemComponents{Allow: false}
+
+					Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(Succeed())
+				})
+
+				It("should not check the machine type if the shoot uses a workload identity", func() {
+					shoot.Spec.SecretBindingName = nil
+					shoot.Spec.CredentialsBindingName = ptr.To("credentials-binding")
+					oldShoot.Spec.SecretBindingName = nil
+					oldShoot.Spec.CredentialsBindingName = ptr.To("credentials-binding")
+					apiReader.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "credentials-binding"}, gomock.AssignableToTypeOf(&securityv1alpha1.CredentialsBinding{})).
+						SetArg(2, securityv1alpha1.CredentialsBinding{CredentialsRef: corev1.ObjectReference{APIVersion: "security.gardener.cloud/v1alpha1", Kind: "WorkloadIdentity", Namespace: namespace, Name: "workload-identity"}})
+
+					Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(Succeed())
+				})
+			})
 		})
 	})
 })
@@ -6,6 +6,7 @@ package config
 
 import (
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -28,6 +29,19 @@ type ControllerConfiguration struct {
 	// or disable alpha/experimental features.
 	// Default: nil
 	FeatureGates map[string]bool
+	// PodSecurity contains the security settings of the pods deployed by the extension into the shoot control planes.
+	PodSecurity *PodSecurity
+	// Bastion is the configuration for the Bastion controller.
+	Bastion *BastionConfiguration
+	// APIEndpoints overrides the endpoints of the GCP APIs used by the extension.
+	APIEndpoints *APIEndpoints
+	// ComputeRateLimits limits the rate of the requests to the Compute Engine API per GCP project.
+	ComputeRateLimits *ComputeRateLimits
+	// CABundle is a PEM encoded bundle of certificate authorities which are trusted in addition to the system ones when
+	// connecting to the GCP APIs.
+	CABundle *string
+	// ComputeOperations configures the waiting for the operations of the Compute Engine API.
+	ComputeOperations *ComputeOperations
 }
 
 // ETCD is an etcd configuration.
@@ -51,3 +65,71 @@ type ETCDBackup struct {
 	// Schedule is the etcd backup schedule.
 	Schedule *string
 }
+
+// APIEndpoints contains the endpoints of the GCP APIs used by the extension. If an endpoint is not set, the default
+// endpoint of the API is used.
+type APIEndpoints struct {
+	// Compute is the endpoint of the Compute Engine API.
+	Compute *string
+	// DNS is the endpoint of the Cloud DNS API.
+	DNS *string
+	// IAM is the endpoint of the IAM API.
+	IAM *string
+	// Storage is the endpoint of the Cloud Storage API.
+	Storage *string
+}
+
+// ComputeRateLimits contains the rate limits of the requests to the Compute Engine API. The limits apply per GCP
+// project and are shared by all controllers of the extension.
+type ComputeRateLimits struct {
+	// Reads is the rate limit of read requests.
+	Reads *RateLimit
+	// Writes is the rate limit of mutating requests.
+	Writes *RateLimit
+	// HeavyOperations is the rate limit of heavy-weight requests, i.e. aggregated list requests.
+	HeavyOperations *RateLimit
+}
+
+// RateLimit is the configuration of a token bucket rate limiter.
+type RateLimit struct {
+	// QPS is the number of requests per second.
+	QPS float32
+	// Burst is the maximum number of requests which may be sent at once.
+	Burst int32
+}
+
+// ComputeOperations configures the waiting for the long-running operations of the Compute Engine API and the deadline
+// of the single requests.
+type ComputeOperations struct {
+	// PollInterval is the interval in which the status of an operation is polled.
+	PollInterval *metav1.Duration
+	// Timeout is the maximum duration to wait for an operation to complete.
+	Timeout *metav1.Duration
+	// RequestTimeout is the deadline of a single request to the Compute Engine API.
+	RequestTimeout *metav1.Duration
+}
+
+// BastionConfiguration is the configuration for the Bastion controller.
+type BastionConfiguration struct {
+	// AllowUnrestrictedIngress allows bastions whose ingress permits SSH connections from any IP address, i.e. 0.0.0.0/0.
+	AllowUnrestrictedIngress bool
+	// IngressExpiration is the duration after the creation of a bastion after which its SSH ingress firewall rule is
+	// removed, even if the bastion still exists.
+	IngressExpiration *metav1.Duration
+	// SessionAuditLogging enables the export of the serial port output of bastion hosts, which contains the logs of their
+	// SSH daemon, to Cloud Logging.
+	SessionAuditLogging bool
+	// DiskSize is the size of the root disk of bastion hosts in GB.
+	DiskSize *int64
+}
+
+// PodSecurity contains the security settings of the pods deployed by the extension into the shoot control planes.
+type PodSecurity struct {
+	// Restricted configures the pods to comply with the "restricted" Pod Security Standard, i.e. the containers run as
+	// non-root user without any capabilities and with the RuntimeDefault seccomp profile.
+	Restricted bool
+	// SeccompProfile is the seccomp profile of the pods. It overwrites the profile of the restricted preset.
+	SeccompProfile *corev1.SeccompProfile
+	// AppArmorProfile is the AppArmor profile of the pods.
+	AppArmorProfile *corev1.AppArmorProfile
+}
@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	healthcheckconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -32,6 +33,28 @@ type ControllerConfiguration struct {
 	// Default: nil
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// PodSecurity contains the security settings of the pods deployed by the extension into the shoot control planes.
+	// +optional
+	PodSecurity *PodSecurity `json:"podSecurity,omitempty"`
+	// Bastion is the configuration for the Bastion controller.
+	// +optional
+	Bastion *BastionConfiguration `json:"bastion,omitempty"`
+	// APIEndpoints overrides the endpoints of the GCP APIs used by the extension, e.g. to send the requests through a
+	// proxy.
+	// +optional
+	APIEndpoints *APIEndpoints `json:"apiEndpoints,omitempty"`
+	// ComputeRateLimits limits the rate of the requests to the Compute Engine API per GCP project, so that the parallel
+	// reconciliation of many shoots does not exhaust the API quotas of their projects.
+	// +optional
+	ComputeRateLimits *ComputeRateLimits `json:"computeRateLimits,omitempty"`
+	// CABundle is a PEM encoded bundle of certificate authorities which are trusted in addition to the system ones when
+	// connecting to the GCP APIs, e.g. the one of a TLS-inspecting proxy.
+	// +optional
+	CABundle *string `json:"caBundle,omitempty"`
+	// ComputeOperations configures the waiting for the long-running operations of the Compute Engine API, e.g. to give
+	// operations in slow regions more time or to not block reconciliations for too long.
+	// +optional
+	ComputeOperations *ComputeOperations `json:"computeOperations,omitempty"`
 }
 
 // ETCD is an etcd configuration.
@@ -58,3 +81,95 @@ type ETCDBackup struct {
 	// +optional
 	Schedule *string `json:"schedule,omitempty"`
 }
+
+// APIEndpoints contains the endpoints of the GCP APIs used by the extension. If an endpoint is not set, the default
+// endpoint of the API is used. The endpoints must contain the base path of the API, e.g.
+// https://compute.example.com/compute/v1/.
+type APIEndpoints struct {
+	// Compute is the endpoint of the Compute Engine API.
+	// +optional
+	Compute *string `json:"compute,omitempty"`
+	// DNS is the endpoint of the Cloud DNS API.
+	// +optional
+	DNS *string `json:"dns,omitempty"`
+	// IAM is the endpoint of the IAM API.
+	// +optional
+	IAM *string `json:"iam,omitempty"`
+	// Storage is the endpoint of the Cloud Storage API.
+	// +optional
+	Storage *string `json:"storage,omitempty"`
+}
+
+// ComputeRateLimits contains the rate limits of the requests to the Compute Engine API. The limits apply per GCP
+// project and are shared by all controllers of the extension. Requests are not limited if no limit is configured for
+// them.
+type ComputeRateLimits struct {
+	// Reads is the rate limit of read requests.
+	// +optional
+	Reads *RateLimit `json:"reads,omitempty"`
+	// Writes is the rate limit of mutating requests.
+	// +optional
+	Writes *RateLimit `json:"writes,omitempty"`
+	// HeavyOperations is the rate limit of heavy-weight requests, i.e. aggregated list requests.
+	// +optional
+	HeavyOperations *RateLimit `json:"heavyOperations,omitempty"`
+}
+
+// RateLimit is the configuration of a token bucket rate limiter.
+type RateLimit struct {
+	// QPS is the number of requests per second.
+	QPS float32 `json:"qps"`
+	// Burst is the maximum number of requests which may be sent at once.
+	Burst int32 `json:"burst"`
+}
+
+// ComputeOperations configures the waiting for the long-running operations of the Compute Engine API and the deadline
+// of the single requests.
+type ComputeOperations struct {
+	// PollInterval is the interval in which the status of an operation is polled.
+	// Defaults to 10s.
+	// +optional
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+	// Timeout is the maximum duration to wait for an operation to complete. If it is not set, the client waits until the
+	// context of the reconciliation is cancelled.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// RequestTimeout is the deadline of a single request to the Compute Engine API, including the polling of the status of
+	// operations. If it is not set, the requests have no deadline of their own.
+	// +optional
+	RequestTimeout *metav1.Duration `json:"requestTimeout,omitempty"`
+}
+
+// BastionConfiguration is the configuration for the Bastion controller.
+type BastionConfiguration struct {
+	// AllowUnrestrictedIngress allows bastions whose ingress permits SSH connections from any IP address, i.e. 0.0.0.0/0.
+	// Defaults to false.
+	// +optional
+	AllowUnrestrictedIngress bool `json:"allowUnrestrictedIngress,omitempty"`
+	// IngressExpiration is the duration after the creation of a bastion after which its SSH ingress firewall rule is
+	// removed, even if the bastion still exists. If it is not set, the rule is only removed together with the bastion.
+	// +optional
+	IngressExpiration *metav1.Duration `json:"ingressExpiration,omitempty"`
+	// SessionAuditLogging enables the export of the serial port output of bastion hosts, which contains the logs of their
+	// SSH daemon, to Cloud Logging. The bastion hosts are labeled with the shoot of the bastion.
+	// Defaults to false.
+	// +optional
+	SessionAuditLogging bool `json:"sessionAuditLogging,omitempty"`
+	// DiskSize is the size of the root disk of bastion hosts in GB. Defaults to 10.
+	// +optional
+	DiskSize *int64 `json:"diskSize,omitempty"`
+}
+
+// PodSecurity contains the security settings of the pods deployed by the extension into the shoot control planes.
+type PodSecurity struct {
+	// Restricted configures the pods to comply with the "restricted" Pod Security Standard, i.e. the containers run as
+	// non-root user without any capabilities and with the RuntimeDefault seccomp profile.
+	// +optional
+	Restricted bool `json:"restricted,omitempty"`
+	// SeccompProfile is the seccomp profile of the pods. It overwrites the profile of the restricted preset.
+	// +optional
+	SeccompProfile *corev1.SeccompProfile `json:"seccompProfile,omitempty"`
+	// AppArmorProfile is the AppArmor profile of the pods.
+	// +optional
+	AppArmorProfile *corev1.AppArmorProfile `json:"appArmorProfile,omitempty"`
+}
@@ -14,7 +14,9 @@ import (
 
 	config "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	v1 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -27,6 +29,46 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*APIEndpoints)(nil), (*config.APIEndpoints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIEndpoints_To_config_APIEndpoints(a.(*APIEndpoints), b.(*config.APIEndpoints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.APIEndpoints)(nil), (*APIEndpoints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_APIEndpoints_To_v1alpha1_APIEndpoints(a.(*config.APIEndpoints), b.(*APIEndpoints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionConfiguration)(nil), (*config.BastionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionConfiguration_To_config_BastionConfiguration(a.(*BastionConfiguration), b.(*config.BastionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.BastionConfiguration)(nil), (*BastionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_BastionConfiguration_To_v1alpha1_BastionConfiguration(a.(*config.BastionConfiguration), b.(*BastionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComputeOperations)(nil), (*config.ComputeOperations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComputeOperations_To_config_ComputeOperations(a.(*ComputeOperations), b.(*config.ComputeOperations), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComputeOperations)(nil), (*ComputeOperations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComputeOperations_To_v1alpha1_ComputeOperations(a.(*config.ComputeOperations), b.(*ComputeOperations), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComputeRateLimits)(nil), (*config.ComputeRateLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComputeRateLimits_To_config_ComputeRateLimits(a.(*ComputeRateLimits), b.(*config.ComputeRateLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComputeRateLimits)(nil), (*ComputeRateLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComputeRateLimits_To_v1alpha1_ComputeRateLimits(a.(*config.ComputeRateLimits), b.(*ComputeRateLimits), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerConfiguration)(nil), (*config.ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(a.(*ControllerConfiguration), b.(*config.ControllerConfiguration), scope)
 	}); err != nil {
@@ -67,9 +109,129 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodSecurity)(nil), (*config.PodSecurity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodSecurity_To_config_PodSecurity(a.(*PodSecurity), b.(*config.PodSecurity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PodSecurity)(nil), (*PodSecurity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PodSecurity_To_v1alpha1_PodSecurity(a.(*config.PodSecurity), b.(*PodSecurity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RateLimit)(nil), (*config.RateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RateLimit_To_config_RateLimit(a.(*RateLimit), b.(*config.RateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.RateLimit)(nil), (*RateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_RateLimit_To_v1alpha1_RateLimit(a.(*config.RateLimit), b.(*RateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_APIEndpoints_To_config_APIEndpoints(in *APIEndpoints, out *config.APIEndpoints, s conversion.Scope) error {
+	out.Compute = (*string)(unsafe.Pointer(in.Compute))
+	out.DNS = (*string)(unsafe.Pointer(in.DNS))
+	out.IAM = (*string)(unsafe.Pointer(in.IAM))
+	out.Storage = (*string)(unsafe.Pointer(in.Storage))
+	return nil
+}
+
+// Convert_v1alpha1_APIEndpoints_To_config_APIEndpoints is an autogenerated conversion function.
+func Convert_v1alpha1_APIEndpoints_To_config_APIEndpoints(in *APIEndpoints, out *config.APIEndpoints, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIEndpoints_To_config_APIEndpoints(in, out, s)
+}
+
+func autoConvert_config_APIEndpoints_To_v1alpha1_APIEndpoints(in *config.APIEndpoints, out *APIEndpoints, s conversion.Scope) error {
+	out.Compute = (*string)(unsafe.Pointer(in.Compute))
+	out.DNS = (*string)(unsafe.Pointer(in.DNS))
+	out.IAM = (*string)(unsafe.Pointer(in.IAM))
+	out.Storage = (*string)(unsafe.Pointer(in.Storage))
+	return nil
+}
+
+// Convert_config_APIEndpoints_To_v1alpha1_APIEndpoints is an autogenerated conversion function.
+func Convert_config_APIEndpoints_To_v1alpha1_APIEndpoints(in *config.APIEndpoints, out *APIEndpoints, s conversion.Scope) error {
+	return autoConvert_config_APIEndpoints_To_v1alpha1_APIEndpoints(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionConfiguration_To_config_BastionConfiguration(in *BastionConfiguration, out *config.BastionConfiguration, s conversion.Scope) error {
+	out.AllowUnrestrictedIngress = in.AllowUnrestrictedIngress
+	out.IngressExpiration = (*metav1.Duration)(unsafe.Pointer(in.IngressExpiration))
+	out.SessionAuditLogging = in.SessionAuditLogging
+	out.DiskSize = (*int64)(unsafe.Pointer(in.DiskSize))
+	return nil
+}
+
+// Convert_v1alpha1_BastionConfiguration_To_config_BastionConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_BastionConfiguration_To_config_BastionConfiguration(in *BastionConfiguration, out *config.BastionConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionConfiguration_To_config_BastionConfiguration(in, out, s)
+}
+
+func autoConvert_config_BastionConfiguration_To_v1alpha1_BastionConfiguration(in *config.BastionConfiguration, out *BastionConfiguration, s conversion.Scope) error {
+	out.AllowUnrestrictedIngress = in.AllowUnrestrictedIngress
+	out.IngressExpiration = (*metav1.Duration)(unsafe.Pointer(in.IngressExpiration))
+	out.SessionAuditLogging = in.SessionAuditLogging
+	out.DiskSize = (*int64)(unsafe.Pointer(in.DiskSize))
+	return nil
+}
+
+// Convert_config_BastionConfiguration_To_v1alpha1_BastionConfiguration is an autogenerated conversion function.
+func Convert_config_BastionConfiguration_To_v1alpha1_BastionConfiguration(in *config.BastionConfiguration, out *BastionConfiguration, s conversion.Scope) error {
+	return autoConvert_config_BastionConfiguration_To_v1alpha1_BastionConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComputeOperations_To_config_ComputeOperations(in *ComputeOperations, out *config.ComputeOperations, s conversion.Scope) error {
+	out.PollInterval = (*metav1.Duration)(unsafe.Pointer(in.PollInterval))
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
+	out.RequestTimeout = (*metav1.Duration)(unsafe.Pointer(in.RequestTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_ComputeOperations_To_config_ComputeOperations is an autogenerated conversion function.
+func Convert_v1alpha1_ComputeOperations_To_config_ComputeOperations(in *ComputeOperations, out *config.ComputeOperations, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComputeOperations_To_config_ComputeOperations(in, out, s)
+}
+
+func autoConvert_config_ComputeOperations_To_v1alpha1_ComputeOperations(in *config.ComputeOperations, out *ComputeOperations, s conversion.Scope) error {
+	out.PollInterval = (*metav1.Duration)(unsafe.Pointer(in.PollInterval))
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
+	out.RequestTimeout = (*metav1.Duration)(unsafe.Pointer(in.RequestTimeout))
+	return nil
+}
+
+// Convert_config_ComputeOperations_To_v1alpha1_ComputeOperations is an autogenerated conversion function.
+func Convert_config_ComputeOperations_To_v1alpha1_ComputeOperations(in *config.ComputeOperations, out *ComputeOperations, s conversion.Scope) error {
+	return autoConvert_config_ComputeOperations_To_v1alpha1_ComputeOperations(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComputeRateLimits_To_config_ComputeRateLimits(in *ComputeRateLimits, out *config.ComputeRateLimits, s conversion.Scope) error {
+	out.Reads = (*config.RateLimit)(unsafe.Pointer(in.Reads))
+	out.Writes = (*config.RateLimit)(unsafe.Pointer(in.Writes))
+	out.HeavyOperations = (*config.RateLimit)(unsafe.Pointer(in.HeavyOperations))
+	return nil
+}
+
+// Convert_v1alpha1_ComputeRateLimits_To_config_ComputeRateLimits is an autogenerated conversion function.
+func Convert_v1alpha1_ComputeRateLimits_To_config_ComputeRateLimits(in *ComputeRateLimits, out *config.ComputeRateLimits, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComputeRateLimits_To_config_ComputeRateLimits(in, out, s)
+}
+
+func autoConvert_config_ComputeRateLimits_To_v1alpha1_ComputeRateLimits(in *config.ComputeRateLimits, out *ComputeRateLimits, s conversion.Scope) error {
+	out.Reads = (*RateLimit)(unsafe.Pointer(in.Reads))
+	out.Writes = (*RateLimit)(unsafe.Pointer(in.Writes))
+	out.HeavyOperations = (*RateLimit)(unsafe.Pointer(in.HeavyOperations))
 	return nil
 }
 
+// Convert_config_ComputeRateLimits_To_v1alpha1_ComputeRateLimits is an autogenerated conversion function.
+func Convert_config_ComputeRateLimits_To_v1alpha1_ComputeRateLimits(in *config.ComputeRateLimits, out *ComputeRateLimits, s conversion.Scope) error {
+	return autoConvert_config_ComputeRateLimits_To_v1alpha1_ComputeRateLimits(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*configv1alpha1.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
 	if err := Convert_v1alpha1_ETCD_To_config_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
@@ -77,6 +239,12 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.PodSecurity = (*config.PodSecurity)(unsafe.Pointer(in.PodSecurity))
+	out.Bastion = (*config.BastionConfiguration)(unsafe.Pointer(in.Bastion))
+	out.APIEndpoints = (*config.APIEndpoints)(unsafe.Pointer(in.APIEndpoints))
+	out.ComputeRateLimits = (*config.ComputeRateLimits)(unsafe.Pointer(in.ComputeRateLimits))
+	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.ComputeOperations = (*config.ComputeOperations)(unsafe.Pointer(in.ComputeOperations))
 	return nil
 }
 
@@ -92,6 +260,12 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.PodSecurity = (*PodSecurity)(unsafe.Pointer(in.PodSecurity))
+	out.Bastion = (*BastionConfiguration)(unsafe.Pointer(in.Bastion))
+	out.APIEndpoints = (*APIEndpoints)(unsafe.Pointer(in.APIEndpoints))
+	out.ComputeRateLimits = (*ComputeRateLimits)(unsafe.Pointer(in.ComputeRateLimits))
+	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.ComputeOperations = (*ComputeOperations)(unsafe.Pointer(in.ComputeOperations))
 	return nil
 }
 
@@ -171,3 +345,49 @@ func autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStora
 func Convert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStorage, out *ETCDStorage, s conversion.Scope) error {
 	return autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in, out, s)
 }
+
+func autoConvert_v1alpha1_PodSecurity_To_config_PodSecurity(in *PodSecurity, out *config.PodSecurity, s conversion.Scope) error {
+	out.Restricted = in.Restricted
+	out.SeccompProfile = (*v1.SeccompProfile)(unsafe.Pointer(in.SeccompProfile))
+	out.AppArmorProfile = (*v1.AppArmorProfile)(unsafe.Pointer(in.AppArmorProfile))
+	return nil
+}
+
+// Convert_v1alpha1_PodSecurity_To_config_PodSecurity is an autogenerated conversion function.
+func Convert_v1alpha1_PodSecurity_To_config_PodSecurity(in *PodSecurity, out *config.PodSecurity, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodSecurity_To_config_PodSecurity(in, out, s)
+}
+
+func autoConvert_config_PodSecurity_To_v1alpha1_PodSecurity(in *config.PodSecurity, out *PodSecurity, s conversion.Scope) error {
+	out.Restricted = in.Restricted
+	out.SeccompProfile = (*v1.SeccompProfile)(unsafe.Pointer(in.SeccompProfile))
+	out.AppArmorProfile = (*v1.AppArmorProfile)(unsafe.Pointer(in.AppArmorProfile))
+	return nil
+}
+
+// Convert_config_PodSecurity_To_v1alpha1_PodSecurity is an autogenerated conversion function.
+func Convert_config_PodSecurity_To_v1alpha1_PodSecurity(in *config.PodSecurity, out *PodSecurity, s conversion.Scope) error {
+	return autoConvert_config_PodSecurity_To_v1alpha1_PodSecurity(in, out, s)
+}
+
+func autoConvert_v1alpha1_RateLimit_To_config_RateLimit(in *RateLimit, out *config.RateLimit, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_v1alpha1_RateLimit_To_config_RateLimit is an autogenerated conversion function.
+func Convert_v1alpha1_RateLimit_To_config_RateLimit(in *RateLimit, out *config.RateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RateLimit_To_config_RateLimit(in, out, s)
+}
+
+func autoConvert_config_RateLimit_To_v1alpha1_RateLimit(in *config.RateLimit, out *RateLimit, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_config_RateLimit_To_v1alpha1_RateLimit is an autogenerated conversion function.
+func Convert_config_RateLimit_To_v1alpha1_RateLimit(in *config.RateLimit, out *RateLimit, s conversion.Scope) error {
+	return autoConvert_config_RateLimit_To_v1alpha1_RateLimit(in, out, s)
+}
@@ -5,9 +5,15 @@
 package helper
 
 import (
+	"errors"
+	"net/http"
 	"regexp"
 
+	"github.com/gardener/gardener/extensions/pkg/util"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"google.golang.org/api/googleapi"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 var (
@@ -34,3 +40,97 @@ var (
 		gardencorev1beta1.ErrorRetryableConfigurationProblem: retryableConfigurationProblemRegexp.MatchString,
 	}
 )
+
+// apiErrorReasonCodes maps the reasons of GCP API errors to the respective Gardener error codes.
+var apiErrorReasonCodes = map[string]gardencorev1beta1.ErrorCode{
+	"quotaExceeded":         gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"rateLimitExceeded":     gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+	"userRateLimitExceeded": gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+	"accessNotConfigured":   gardencorev1beta1.ErrorInfraDependencies,
+	"SERVICE_DISABLED":      gardencorev1beta1.ErrorInfraDependencies,
+	// resourceInUseByAnotherResource is returned when a resource is deleted which is still referenced, e.g. a subnet
+	// which is still used by VMs which were not created by Gardener.
+	"resourceInUseByAnotherResource": gardencorev1beta1.ErrorInfraDependencies,
+}
+
+// apiErrorStatusCodes maps the HTTP status codes of GCP API errors to the respective Gardener error codes. They are
+// only used if the reasons of an error are not known.
+var apiErrorStatusCodes = map[int]gardencorev1beta1.ErrorCode{
+	http.StatusUnauthorized:    gardencorev1beta1.ErrorInfraUnauthenticated,
+	http.StatusForbidden:       gardencorev1beta1.ErrorInfraUnauthorized,
+	http.StatusTooManyRequests: gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+}
+
+// unclassifiedStatusCodes are the HTTP status codes of GCP API errors which are not classified unless their reasons are
+// known. Such errors are usually caused by the eventual consistency of the GCP APIs or by concurrent operations, hence
+// they must be retried, which would be prevented by non-retryable error codes.
+var unclassifiedStatusCodes = sets.New(http.StatusNotFound, http.StatusConflict)
+
+// DetermineError returns the given error with the Gardener error codes determined by DetermineErrorCodes. The error is
+// returned as it is if it already has error codes or if no error code could be determined.
+func DetermineError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var coder v1beta1helper.Coder
+	if errors.As(err, &coder) {
+		return err
+	}
+
+	codes := DetermineErrorCodes(err)
+	if len(codes) == 0 {
+		return err
+	}
+	return v1beta1helper.NewErrorWithCodes(err, codes...)
+}
+
+// DetermineErrorCodes determines the Gardener error codes of the given error. Errors of the GCP APIs are classified by
+// their reasons and HTTP status code, all other errors by matching their message against the KnownCodes.
+func DetermineErrorCodes(err error) []gardencorev1beta1.ErrorCode {
+	if err == nil {
+		return nil
+	}
+
+	if codes, ok := apiErrorCodes(err); ok {
+		return codes
+	}
+	return util.DetermineErrorCodes(err, KnownCodes)
+}
+
+// apiErrorCodes determines the Gardener error codes of GCP API errors. It returns false if the error is no GCP API error
+// or could not be classified.
+func apiErrorCodes(err error) ([]gardencorev1beta1.ErrorCode, bool) {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		return nil, false
+	}
+
+	if code, ok := apiErrorCode(apiErr); ok {
+		return []gardencorev1beta1.ErrorCode{code}, true
+	}
+	if unclassifiedStatusCodes.Has(apiErr.Code) {
+		return nil, true
+	}
+	return nil, false
+}
+
+func apiErrorCode(apiErr *googleapi.Error) (gardencorev1beta1.ErrorCode, bool) {
+	for _, item := range apiErr.Errors {
+		if code, ok := apiErrorReasonCodes[item.Reason]; ok {
+			return code, true
+		}
+	}
+	for _, detail := range apiErr.Details {
+		if info, ok := detail.(map[string]any); ok {
+			if reason, ok := info["reason"].(string); ok {
+				if code, ok := apiErrorReasonCodes[reason]; ok {
+					return code, true
+				}
+			}
+		}
+	}
+
+	code, ok := apiErrorStatusCodes[apiErr.Code]
+	return code, ok
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/googleapi"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+)
+
+var _ = Describe("Error codes", func() {
+	apiError := func(code int, reason string) error {
+		err := &googleapi.Error{Code: code, Message: "some message"}
+		if reason != "" {
+			err.Errors = []googleapi.ErrorItem{{Reason: reason}}
+		}
+		return fmt.Errorf("failed to do something: %w", err)
+	}
+
+	DescribeTable("#DetermineErrorCodes",
+		func(err error, expected []gardencorev1beta1.ErrorCode) {
+			Expect(DetermineErrorCodes(err)).To(Equal(expected))
+		},
+
+		Entry("nil error", nil, nil),
+		Entry("quota exceeded", apiError(http.StatusForbidden, "quotaExceeded"), []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded}),
+		Entry("rate limit exceeded", apiError(http.StatusForbidden, "rateLimitExceeded"), []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraRateLimitsExceeded}),
+		Entry("API not enabled", apiError(http.StatusForbidden, "accessNotConfigured"), []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraDependencies}),
+		Entry("permission denied", apiError(http.StatusForbidden, "forbidden"), []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthorized}),
+		Entry("unauthenticated", apiError(http.StatusUnauthorized, ""), []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthenticated}),
+		Entry("not found", apiError(http.StatusNotFound, "notFound"), nil),
+		Entry("not found with message of unknown reason", &googleapi.Error{Code: http.StatusNotFound, Message: "The resource was not found, notFound"}, nil),
+		Entry("conflict", apiError(http.StatusConflict, "alreadyExists"), nil),
+		Entry("resource in use", apiError(http.StatusBadRequest, "resourceInUseByAnotherResource"), []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraDependencies}),
+		Entry("too many requests", apiError(http.StatusTooManyRequests, ""), []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraRateLimitsExceeded}),
+		Entry("unknown API error", apiError(http.StatusInternalServerError, ""), nil),
+		Entry("error message", errors.New("operation failed: QUOTA_EXCEEDED"), []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded}),
+	)
+
+	Describe("#DetermineError", func() {
+		It("should add the error codes to the error", func() {
+			err := DetermineError(apiError(http.StatusForbidden, "quotaExceeded"))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorInfraQuotaExceeded))
+		})
+
+		It("should keep the codes of errors which already have codes", func() {
+			err := v1beta1helper.NewErrorWithCodes(errors.New("foo"), gardencorev1beta1.ErrorConfigurationProblem)
+			Expect(DetermineError(err)).To(BeIdenticalTo(err))
+		})
+
+		It("should return errors without codes unchanged", func() {
+			err := errors.New("foo")
+			Expect(DetermineError(err)).To(BeIdenticalTo(err))
+		})
+	})
+})
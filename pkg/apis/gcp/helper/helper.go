@@ -11,6 +11,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
 // FindSubnetByPurpose takes a list of subnets and tries to find the first entry
@@ -59,3 +60,10 @@ func FindImageFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, image
 
 	return "", fmt.Errorf("could not find an image for name %q and architecture %q in version %q", imageName, *architecture, imageVersion)
 }
+
+// IsAliasIPModeEnabled returns true if the pod ranges of the nodes are managed as alias IP ranges according to the
+// given control plane configuration.
+func IsAliasIPModeEnabled(config *api.ControlPlaneConfig) bool {
+	return config != nil && config.CloudControllerManager != nil &&
+		ptr.Deref(config.CloudControllerManager.NodeIPAMMode, "") == gcp.NodeIPAMModeAliasIP
+}
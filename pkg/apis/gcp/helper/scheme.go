@@ -62,6 +62,64 @@ func InfrastructureStatusFromRaw(raw *runtime.RawExtension) (*api.Infrastructure
 	return nil, fmt.Errorf("provider status is not set on the infrastructure resource")
 }
 
+// ControlPlaneConfigFromControlPlane extracts the ControlPlaneConfig from the
+// ProviderConfig section of the given ControlPlane.
+func ControlPlaneConfigFromControlPlane(cp *extensionsv1alpha1.ControlPlane) (*api.ControlPlaneConfig, error) {
+	config := &api.ControlPlaneConfig{}
+	if cp.Spec.ProviderConfig != nil && cp.Spec.ProviderConfig.Raw != nil {
+		if _, _, err := decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// ControlPlaneStatusFromControlPlane extracts the ControlPlaneStatus from the ProviderStatus section of the given
+// ControlPlane. An empty status is returned if the ProviderStatus is not set.
+func ControlPlaneStatusFromControlPlane(cp *extensionsv1alpha1.ControlPlane) (*api.ControlPlaneStatus, error) {
+	status := &api.ControlPlaneStatus{}
+	if cp.Status.ProviderStatus != nil && cp.Status.ProviderStatus.Raw != nil {
+		if _, _, err := lenientDecoder.Decode(cp.Status.ProviderStatus.Raw, nil, status); err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
+
+// ControlPlaneConfigFromCluster decodes the provider specific control plane configuration of the shoot in the
+// given cluster.
+func ControlPlaneConfigFromCluster(cluster *controller.Cluster) (*api.ControlPlaneConfig, error) {
+	config := &api.ControlPlaneConfig{}
+	if cluster != nil && cluster.Shoot != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw != nil {
+		if _, _, err := decoder.Decode(cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw, nil, config); err != nil {
+			return nil, fmt.Errorf("could not decode controlPlaneConfig of shoot '%s/%s': %w", cluster.Shoot.Namespace, cluster.Shoot.Name, err)
+		}
+	}
+	return config, nil
+}
+
+// WorkerConfigFromRawExtension extracts the WorkerConfig from the given provider config of a worker pool.
+func WorkerConfigFromRawExtension(raw *runtime.RawExtension) (*api.WorkerConfig, error) {
+	config := &api.WorkerConfig{}
+	if raw != nil && raw.Raw != nil {
+		if _, _, err := decoder.Decode(raw.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// DNSRecordConfigFromDNSRecord extracts the DNSRecordConfig from the ProviderConfig section of the given DNSRecord.
+func DNSRecordConfigFromDNSRecord(dns *extensionsv1alpha1.DNSRecord) (*api.DNSRecordConfig, error) {
+	config := &api.DNSRecordConfig{}
+	if dns.Spec.ProviderConfig != nil && dns.Spec.ProviderConfig.Raw != nil {
+		if _, _, err := decoder.Decode(dns.Spec.ProviderConfig.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
 // CloudProfileConfigFromCluster decodes the provider specific cloud profile configuration for a cluster
 func CloudProfileConfigFromCluster(cluster *controller.Cluster) (*api.CloudProfileConfig, error) {
 	var cloudProfileConfig *api.CloudProfileConfig
@@ -40,9 +40,11 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&InfrastructureStatus{},
 		&InfrastructureState{},
 		&ControlPlaneConfig{},
+		&ControlPlaneStatus{},
 		&WorkerStatus{},
 		&WorkerConfig{},
 		&BackupBucketConfig{},
+		&DNSRecordConfig{},
 		&WorkloadIdentityConfig{},
 	)
 	return nil
@@ -5,6 +5,7 @@
 package gcp
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -23,12 +24,136 @@ type ControlPlaneConfig struct {
 
 	// Storage contains configuration for the storage in the cluster.
 	Storage *Storage
+
+	// CSIDriverController contains configuration settings for the csi-driver-controller.
+	CSIDriverController *CSIDriverControllerConfig
+
+	// CSIDriverNode contains configuration settings for the csi-driver-node.
+	CSIDriverNode *CSIDriverNodeConfig
+
+	// IngressGCE contains configuration settings for the ingress-gce controller.
+	IngressGCE *IngressGCEConfig
+
+	// LoadBalancer contains the defaults for Services of type LoadBalancer.
+	LoadBalancer *LoadBalancerConfig
+
+	// KMS contains configuration for encrypting secrets of the kube-apiserver with a Cloud KMS key.
+	KMS *KMSConfig
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ControlPlaneStatus contains information about the resources which were created in GCP for the control plane.
+type ControlPlaneStatus struct {
+	metav1.TypeMeta
+
+	// StoragePools are the names of the storage pools in GCP which were created for the shoot.
+	StoragePools []string
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	FeatureGates map[string]bool
+	// Flags contains additional flags for the cloud-controller-manager, mapping the flag name (without leading
+	// dashes) to its value. Only a limited set of flags is supported.
+	Flags map[string]string
+	// NodeIPAMMode selects how the pod ranges of the nodes are managed. With "Routes" the cloud-controller-manager
+	// allocates the ranges and creates a VPC route per node. With "AliasIP" the ranges are allocated as alias IP ranges
+	// from the secondary range of the nodes subnet.
+	// Defaults to "Routes" unless an overlay network or dual-stack networking is used.
+	NodeIPAMMode *string
+	// Replicas is the number of replicas of the cloud-controller-manager if the control plane is not scaled down.
+	// If not set, the replicas are determined by the high availability settings of the shoot.
+	Replicas *int32
+	// Resources are the resource requirements of the cloud-controller-manager container. The requests are the
+	// initial values which are adjusted by the vertical pod autoscaler afterwards.
+	Resources *corev1.ResourceRequirements
+}
+
+// CSIDriverControllerConfig contains configuration settings for the csi-driver-controller.
+type CSIDriverControllerConfig struct {
+	// Provisioner contains configuration settings for the csi-provisioner sidecar.
+	Provisioner *CSISidecarConfig
+	// Attacher contains configuration settings for the csi-attacher sidecar.
+	Attacher *CSISidecarConfig
+	// Resizer contains configuration settings for the csi-resizer sidecar.
+	Resizer *CSISidecarConfig
+	// MetricsEnabled enables the metrics of the csi-driver-controller together with their scrape configuration, a
+	// dashboard and alerts in the control plane monitoring.
+	// Defaults to false.
+	MetricsEnabled *bool
+	// Replicas is the number of replicas of the csi-driver-controller if the control plane is not scaled down.
+	// If not set, the replicas are determined by the high availability settings of the shoot.
+	Replicas *int32
+	// Resources are the resource requirements of the csi-driver container. The requests are the initial values
+	// which are adjusted by the vertical pod autoscaler afterwards.
+	Resources *corev1.ResourceRequirements
+	// SnapshotsEnabled controls whether the csi-snapshotter sidecar and the csi-snapshot-controller are deployed.
+	// Defaults to true.
+	SnapshotsEnabled *bool
+	// ResizerEnabled controls whether the csi-resizer sidecar is deployed.
+	// Defaults to true.
+	ResizerEnabled *bool
+}
+
+// CSIDriverNodeConfig contains configuration settings for the csi-driver-node.
+type CSIDriverNodeConfig struct {
+	// Enabled controls whether the node plugin of the CSI driver is deployed to the nodes.
+	// Defaults to true.
+	Enabled *bool
+}
+
+// IngressGCEConfig contains configuration settings for the ingress-gce controller.
+type IngressGCEConfig struct {
+	// Enabled controls whether the ingress-gce controller is deployed. It provisions Google Cloud HTTP(S) load balancers
+	// for Ingresses of class "gce" and standalone network endpoint groups (NEGs) for Services, which enables
+	// container-native load balancing. It is always deployed for dual-stack shoots.
+	// Defaults to false.
+	Enabled *bool
+}
+
+// CSISidecarConfig contains configuration settings for a sidecar of the csi-driver-controller.
+type CSISidecarConfig struct {
+	// Timeout is the timeout for the calls of the sidecar to the CSI driver.
+	Timeout *metav1.Duration
+	// Workers is the number of goroutines concurrently processing volume operations.
+	Workers *int32
+	// Resources are the resource requirements of the sidecar container. The requests are the initial values which
+	// are adjusted by the vertical pod autoscaler afterwards.
+	Resources *corev1.ResourceRequirements
+}
+
+// KMSConfig contains configuration for the envelope encryption of secrets with a Cloud KMS key.
+type KMSConfig struct {
+	// KeyName is the resource name of the Cloud KMS key which is used to encrypt the data encryption keys, e.g.
+	// 'projects/<project>/locations/<location>/keyRings/<key-ring>/cryptoKeys/<key>'.
+	KeyName string
+}
+
+// LoadBalancerConfig contains the defaults for Services of type LoadBalancer.
+type LoadBalancerConfig struct {
+	// Type is the type of the load balancers created for Services which do not specify the
+	// 'networking.gke.io/load-balancer-type' annotation. Either "External" or "Internal".
+	// Defaults to "External".
+	Type *string
+	// GlobalAccess controls if internal load balancers are accessible from all regions for Services which do not
+	// specify the 'networking.gke.io/internal-load-balancer-allow-global-access' annotation.
+	// Defaults to false.
+	GlobalAccess *bool
+	// Subnet is the name of the subnet in which internal load balancers are created. If not set, the internal subnet
+	// of the infrastructure is used.
+	Subnet *string
+	// NetworkTier is the network tier of external load balancers created for Services which do not specify the
+	// 'cloud.google.com/network-tier' annotation. Either "Premium" or "Standard".
+	// Defaults to "Premium".
+	NetworkTier *string
+	// SourceRanges are the CIDRs which are allowed to access load balancers created for Services which do not
+	// specify source ranges. If not set, load balancers are accessible from everywhere.
+	SourceRanges []string
+	// FirewallTargetTags are additional network tags which are added to the target tags of the firewall rules
+	// created for load balancers.
+	FirewallTargetTags []string
 }
 
 // Storage contains settings for the default StorageClass and VolumeSnapshotClass
@@ -43,4 +168,84 @@ type Storage struct {
 	// not managed by Gardener to be set as default by the user.
 	// Defaults to true.
 	ManagedDefaultVolumeSnapshotClass *bool
+	// AllowVolumeExpansion controls if the StorageClasses managed by Gardener allow the expansion of volumes.
+	// Defaults to true.
+	AllowVolumeExpansion *bool
+	// VolumeSnapshotClass contains settings for the 'default' VolumeSnapshotClass.
+	VolumeSnapshotClass *VolumeSnapshotClass
+	// StorageClasses is the list of StorageClasses which are managed in the shoot cluster. If set, it replaces the
+	// StorageClasses deployed by default ('default', 'gce-sc-hdd' and 'gce-sc-fast').
+	StorageClasses []StorageClass
+	// StoragePools is the list of Hyperdisk Storage Pools which are created for the shoot cluster. The pools can be
+	// referenced by the StorageClasses to provision volumes in the pooled capacity.
+	StoragePools []StoragePool
+	// Topology contains settings for the topology-aware provisioning of volumes.
+	Topology *StorageTopology
+}
+
+// StorageTopology contains settings for the topology-aware provisioning of volumes.
+type StorageTopology struct {
+	// AllowedZones restricts the zones in which volumes of the StorageClasses managed by Gardener are provisioned.
+	// If not set, volumes can be provisioned in all zones of the region.
+	AllowedZones []string
+	// VolumeBindingMode is the volume binding mode of the StorageClasses managed by Gardener.
+	// Either "WaitForFirstConsumer" or "Immediate". Defaults to "WaitForFirstConsumer".
+	VolumeBindingMode *string
+	// StrictTopology controls if volumes are only provisioned in the zone of the node selected by the scheduler.
+	// If false, the other allowed zones are passed to the CSI driver as preferred zones, e.g. for regional disks.
+	// Defaults to false.
+	StrictTopology *bool
+}
+
+// StorageClass contains the settings for a StorageClass managed in the shoot cluster.
+type StorageClass struct {
+	// Name is the name of the StorageClass.
+	Name string
+	// Type is the GCP disk type of the provisioned volumes, e.g. pd-balanced or hyperdisk-balanced.
+	Type string
+	// Default controls if the StorageClass is marked as default. Only considered if ManagedDefaultStorageClass is true.
+	Default *bool
+	// ReplicationType is the replication type of the provisioned volumes. Either "none" or "regional-pd".
+	// Defaults to "none".
+	ReplicationType *string
+	// ProvisionedIops is the IOPS provisioned for the volumes on creation.
+	// Only for certain types of disk, see worker.AllowedTypesIops
+	ProvisionedIops *int64
+	// ProvisionedThroughput is the throughput in MiB per second provisioned for the volumes on creation.
+	// Only for certain types of disk, see worker.AllowedTypesThroughput
+	ProvisionedThroughput *int64
+	// StoragePools are the names of the storage pools in which the volumes are provisioned. The type of the
+	// storage pools must match the type of the StorageClass.
+	StoragePools []string
+}
+
+// StoragePool contains the settings for a Hyperdisk Storage Pool.
+type StoragePool struct {
+	// Name is the name of the storage pool. The name of the storage pool in GCP is prefixed with the technical ID of the shoot.
+	Name string
+	// Zone is the zone of the storage pool.
+	Zone string
+	// Type is the type of the storage pool. Either "hyperdisk-balanced" or "hyperdisk-throughput".
+	Type string
+	// ProvisionedCapacity is the provisioned capacity of the storage pool in GiB.
+	ProvisionedCapacity int64
+	// ProvisionedIops is the IOPS provisioned for the storage pool. Required for "hyperdisk-balanced" storage pools.
+	ProvisionedIops *int64
+	// ProvisionedThroughput is the throughput in MiB per second provisioned for the storage pool.
+	ProvisionedThroughput int64
+}
+
+// VolumeSnapshotClass contains settings for the 'default' VolumeSnapshotClass.
+type VolumeSnapshotClass struct {
+	// SnapshotType is the type of the snapshots created by the CSI driver. Either "snapshots" or "images".
+	// Defaults to "snapshots".
+	SnapshotType *string
+	// StorageLocation is the Cloud Storage multi-region or region in which the snapshots are stored.
+	// If not set, the location closest to the source disk is used.
+	StorageLocation *string
+	// Labels are additional GCP labels which are added to the created snapshots.
+	Labels map[string]string
+	// DeletionPolicy is the deletion policy of the VolumeSnapshotClass. Either "Delete" or "Retain".
+	// Defaults to "Delete".
+	DeletionPolicy *string
 }
@@ -19,4 +19,6 @@ type WorkloadIdentityConfig struct {
 	ProjectID string
 	// CredentialsConfig contains information for workload authentication against GCP.
 	CredentialsConfig *runtime.RawExtension
+	// QuotaProject is the ID of the GCP project which is used for the quota and billing of the API requests.
+	QuotaProject string
 }
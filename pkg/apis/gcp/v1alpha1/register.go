@@ -34,9 +34,11 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&InfrastructureStatus{},
 		&InfrastructureState{},
 		&ControlPlaneConfig{},
+		&ControlPlaneStatus{},
 		&WorkerStatus{},
 		&WorkerConfig{},
 		&BackupBucketConfig{},
+		&DNSRecordConfig{},
 		&WorkloadIdentityConfig{},
 	)
 	return nil
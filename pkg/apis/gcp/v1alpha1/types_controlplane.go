@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -24,6 +25,37 @@ type ControlPlaneConfig struct {
 
 	// Storage contains configuration for the storage in the cluster.
 	Storage *Storage `json:"storage,omitempty"`
+
+	// CSIDriverController contains configuration settings for the csi-driver-controller.
+	// +optional
+	CSIDriverController *CSIDriverControllerConfig `json:"csiDriverController,omitempty"`
+
+	// CSIDriverNode contains configuration settings for the csi-driver-node.
+	// +optional
+	CSIDriverNode *CSIDriverNodeConfig `json:"csiDriverNode,omitempty"`
+
+	// IngressGCE contains configuration settings for the ingress-gce controller.
+	// +optional
+	IngressGCE *IngressGCEConfig `json:"ingressGCE,omitempty"`
+
+	// LoadBalancer contains the defaults for Services of type LoadBalancer.
+	// +optional
+	LoadBalancer *LoadBalancerConfig `json:"loadBalancer,omitempty"`
+
+	// KMS contains configuration for encrypting secrets of the kube-apiserver with a Cloud KMS key.
+	// +optional
+	KMS *KMSConfig `json:"kms,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ControlPlaneStatus contains information about the resources which were created in GCP for the control plane.
+type ControlPlaneStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// StoragePools are the names of the storage pools in GCP which were created for the shoot.
+	// +optional
+	StoragePools []string `json:"storagePools,omitempty"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -31,6 +63,128 @@ type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// Flags contains additional flags for the cloud-controller-manager, mapping the flag name (without leading
+	// dashes) to its value. Only a limited set of flags is supported.
+	// +optional
+	Flags map[string]string `json:"flags,omitempty"`
+	// NodeIPAMMode selects how the pod ranges of the nodes are managed. With "Routes" the cloud-controller-manager
+	// allocates the ranges and creates a VPC route per node. With "AliasIP" the ranges are allocated as alias IP ranges
+	// from the secondary range of the nodes subnet.
+	// Defaults to "Routes" unless an overlay network or dual-stack networking is used.
+	// +optional
+	NodeIPAMMode *string `json:"nodeIPAMMode,omitempty"`
+	// Replicas is the number of replicas of the cloud-controller-manager if the control plane is not scaled down.
+	// If not set, the replicas are determined by the high availability settings of the shoot.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resources are the resource requirements of the cloud-controller-manager container. The requests are the
+	// initial values which are adjusted by the vertical pod autoscaler afterwards.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// CSIDriverControllerConfig contains configuration settings for the csi-driver-controller.
+type CSIDriverControllerConfig struct {
+	// Provisioner contains configuration settings for the csi-provisioner sidecar.
+	// +optional
+	Provisioner *CSISidecarConfig `json:"provisioner,omitempty"`
+	// Attacher contains configuration settings for the csi-attacher sidecar.
+	// +optional
+	Attacher *CSISidecarConfig `json:"attacher,omitempty"`
+	// Resizer contains configuration settings for the csi-resizer sidecar.
+	// +optional
+	Resizer *CSISidecarConfig `json:"resizer,omitempty"`
+	// MetricsEnabled enables the metrics of the csi-driver-controller together with their scrape configuration, a
+	// dashboard and alerts in the control plane monitoring.
+	// Defaults to false.
+	// +optional
+	MetricsEnabled *bool `json:"metricsEnabled,omitempty"`
+	// Replicas is the number of replicas of the csi-driver-controller if the control plane is not scaled down.
+	// If not set, the replicas are determined by the high availability settings of the shoot.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resources are the resource requirements of the csi-driver container. The requests are the initial values
+	// which are adjusted by the vertical pod autoscaler afterwards.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// SnapshotsEnabled controls whether the csi-snapshotter sidecar and the csi-snapshot-controller are deployed.
+	// Defaults to true.
+	// +optional
+	SnapshotsEnabled *bool `json:"snapshotsEnabled,omitempty"`
+	// ResizerEnabled controls whether the csi-resizer sidecar is deployed.
+	// Defaults to true.
+	// +optional
+	ResizerEnabled *bool `json:"resizerEnabled,omitempty"`
+}
+
+// CSIDriverNodeConfig contains configuration settings for the csi-driver-node.
+type CSIDriverNodeConfig struct {
+	// Enabled controls whether the node plugin of the CSI driver is deployed to the nodes.
+	// Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// IngressGCEConfig contains configuration settings for the ingress-gce controller.
+type IngressGCEConfig struct {
+	// Enabled controls whether the ingress-gce controller is deployed. It provisions Google Cloud HTTP(S) load balancers
+	// for Ingresses of class "gce" and standalone network endpoint groups (NEGs) for Services, which enables
+	// container-native load balancing. It is always deployed for dual-stack shoots.
+	// Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// CSISidecarConfig contains configuration settings for a sidecar of the csi-driver-controller.
+type CSISidecarConfig struct {
+	// Timeout is the timeout for the calls of the sidecar to the CSI driver.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Workers is the number of goroutines concurrently processing volume operations.
+	// +optional
+	Workers *int32 `json:"workers,omitempty"`
+	// Resources are the resource requirements of the sidecar container. The requests are the initial values which
+	// are adjusted by the vertical pod autoscaler afterwards.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// KMSConfig contains configuration for the envelope encryption of secrets with a Cloud KMS key.
+type KMSConfig struct {
+	// KeyName is the resource name of the Cloud KMS key which is used to encrypt the data encryption keys, e.g.
+	// 'projects/<project>/locations/<location>/keyRings/<key-ring>/cryptoKeys/<key>'.
+	KeyName string `json:"keyName"`
+}
+
+// LoadBalancerConfig contains the defaults for Services of type LoadBalancer.
+type LoadBalancerConfig struct {
+	// Type is the type of the load balancers created for Services which do not specify the
+	// 'networking.gke.io/load-balancer-type' annotation. Either "External" or "Internal".
+	// Defaults to "External".
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// GlobalAccess controls if internal load balancers are accessible from all regions for Services which do not
+	// specify the 'networking.gke.io/internal-load-balancer-allow-global-access' annotation.
+	// Defaults to false.
+	// +optional
+	GlobalAccess *bool `json:"globalAccess,omitempty"`
+	// Subnet is the name of the subnet in which internal load balancers are created. If not set, the internal subnet
+	// of the infrastructure is used.
+	// +optional
+	Subnet *string `json:"subnet,omitempty"`
+	// NetworkTier is the network tier of external load balancers created for Services which do not specify the
+	// 'cloud.google.com/network-tier' annotation. Either "Premium" or "Standard".
+	// Defaults to "Premium".
+	// +optional
+	NetworkTier *string `json:"networkTier,omitempty"`
+	// SourceRanges are the CIDRs which are allowed to access load balancers created for Services which do not
+	// specify source ranges. If not set, load balancers are accessible from everywhere.
+	// +optional
+	SourceRanges []string `json:"sourceRanges,omitempty"`
+	// FirewallTargetTags are additional network tags which are added to the target tags of the firewall rules
+	// created for load balancers.
+	// +optional
+	FirewallTargetTags []string `json:"firewallTargetTags,omitempty"`
 }
 
 // Storage contains settings for the default StorageClass and VolumeSnapshotClass
@@ -47,4 +201,102 @@ type Storage struct {
 	// Defaults to true.
 	// +optional
 	ManagedDefaultVolumeSnapshotClass *bool `json:"managedDefaultVolumeSnapshotClass,omitempty"`
+	// AllowVolumeExpansion controls if the StorageClasses managed by Gardener allow the expansion of volumes.
+	// Defaults to true.
+	// +optional
+	AllowVolumeExpansion *bool `json:"allowVolumeExpansion,omitempty"`
+	// VolumeSnapshotClass contains settings for the 'default' VolumeSnapshotClass.
+	// +optional
+	VolumeSnapshotClass *VolumeSnapshotClass `json:"volumeSnapshotClass,omitempty"`
+	// StorageClasses is the list of StorageClasses which are managed in the shoot cluster. If set, it replaces the
+	// StorageClasses deployed by default ('default', 'gce-sc-hdd' and 'gce-sc-fast').
+	// +optional
+	StorageClasses []StorageClass `json:"storageClasses,omitempty"`
+	// StoragePools is the list of Hyperdisk Storage Pools which are created for the shoot cluster. The pools can be
+	// referenced by the StorageClasses to provision volumes in the pooled capacity.
+	// +optional
+	StoragePools []StoragePool `json:"storagePools,omitempty"`
+	// Topology contains settings for the topology-aware provisioning of volumes.
+	// +optional
+	Topology *StorageTopology `json:"topology,omitempty"`
+}
+
+// StorageTopology contains settings for the topology-aware provisioning of volumes.
+type StorageTopology struct {
+	// AllowedZones restricts the zones in which volumes of the StorageClasses managed by Gardener are provisioned.
+	// If not set, volumes can be provisioned in all zones of the region.
+	// +optional
+	AllowedZones []string `json:"allowedZones,omitempty"`
+	// VolumeBindingMode is the volume binding mode of the StorageClasses managed by Gardener.
+	// Either "WaitForFirstConsumer" or "Immediate". Defaults to "WaitForFirstConsumer".
+	// +optional
+	VolumeBindingMode *string `json:"volumeBindingMode,omitempty"`
+	// StrictTopology controls if volumes are only provisioned in the zone of the node selected by the scheduler.
+	// If false, the other allowed zones are passed to the CSI driver as preferred zones, e.g. for regional disks.
+	// Defaults to false.
+	// +optional
+	StrictTopology *bool `json:"strictTopology,omitempty"`
+}
+
+// StorageClass contains the settings for a StorageClass managed in the shoot cluster.
+type StorageClass struct {
+	// Name is the name of the StorageClass.
+	Name string `json:"name"`
+	// Type is the GCP disk type of the provisioned volumes, e.g. pd-balanced or hyperdisk-balanced.
+	Type string `json:"type"`
+	// Default controls if the StorageClass is marked as default. Only considered if ManagedDefaultStorageClass is true.
+	// +optional
+	Default *bool `json:"default,omitempty"`
+	// ReplicationType is the replication type of the provisioned volumes. Either "none" or "regional-pd".
+	// Defaults to "none".
+	// +optional
+	ReplicationType *string `json:"replicationType,omitempty"`
+	// ProvisionedIops is the IOPS provisioned for the volumes on creation.
+	// Only for certain types of disk, see worker.AllowedTypesIops
+	// +optional
+	ProvisionedIops *int64 `json:"provisionedIops,omitempty"`
+	// ProvisionedThroughput is the throughput in MiB per second provisioned for the volumes on creation.
+	// Only for certain types of disk, see worker.AllowedTypesThroughput
+	// +optional
+	ProvisionedThroughput *int64 `json:"provisionedThroughput,omitempty"`
+	// StoragePools are the names of the storage pools in which the volumes are provisioned. The type of the
+	// storage pools must match the type of the StorageClass.
+	// +optional
+	StoragePools []string `json:"storagePools,omitempty"`
+}
+
+// StoragePool contains the settings for a Hyperdisk Storage Pool.
+type StoragePool struct {
+	// Name is the name of the storage pool. The name of the storage pool in GCP is prefixed with the technical ID of the shoot.
+	Name string `json:"name"`
+	// Zone is the zone of the storage pool.
+	Zone string `json:"zone"`
+	// Type is the type of the storage pool. Either "hyperdisk-balanced" or "hyperdisk-throughput".
+	Type string `json:"type"`
+	// ProvisionedCapacity is the provisioned capacity of the storage pool in GiB.
+	ProvisionedCapacity int64 `json:"provisionedCapacity"`
+	// ProvisionedIops is the IOPS provisioned for the storage pool. Required for "hyperdisk-balanced" storage pools.
+	// +optional
+	ProvisionedIops *int64 `json:"provisionedIops,omitempty"`
+	// ProvisionedThroughput is the throughput in MiB per second provisioned for the storage pool.
+	ProvisionedThroughput int64 `json:"provisionedThroughput"`
+}
+
+// VolumeSnapshotClass contains settings for the 'default' VolumeSnapshotClass.
+type VolumeSnapshotClass struct {
+	// SnapshotType is the type of the snapshots created by the CSI driver. Either "snapshots" or "images".
+	// Defaults to "snapshots".
+	// +optional
+	SnapshotType *string `json:"snapshotType,omitempty"`
+	// StorageLocation is the Cloud Storage multi-region or region in which the snapshots are stored.
+	// If not set, the location closest to the source disk is used.
+	// +optional
+	StorageLocation *string `json:"storageLocation,omitempty"`
+	// Labels are additional GCP labels which are added to the created snapshots.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// DeletionPolicy is the deletion policy of the VolumeSnapshotClass. Either "Delete" or "Retain".
+	// Defaults to "Delete".
+	// +optional
+	DeletionPolicy *string `json:"deletionPolicy,omitempty"`
 }
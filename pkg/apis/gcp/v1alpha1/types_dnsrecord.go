@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DNSRecordConfig represents the configuration for a DNS record.
+type DNSRecordConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// RoutingPolicy is the routing policy of the DNS record. If set, the values of the DNS record form a single item of
+	// the routing policy of the resource record set, which can be shared by multiple DNS records with the same name and
+	// record type.
+	// +optional
+	RoutingPolicy *DNSRoutingPolicy `json:"routingPolicy,omitempty"`
+
+	// ProjectID is the ID of the GCP project hosting the managed zones of the DNS record, e.g. a central DNS project.
+	// The credentials of the DNS record must be permitted to manage resource record sets in this project.
+	// Defaults to the project of the credentials.
+	// +optional
+	ProjectID *string `json:"projectID,omitempty"`
+
+	// Target is a GCP resource whose IP address is used as the value of the DNS record instead of the values in its
+	// spec, similar to alias records. The IP address is resolved on every reconciliation of the DNS record. Only A and
+	// AAAA records can have a target.
+	// +optional
+	Target *DNSRecordTarget `json:"target,omitempty"`
+
+	// ManagedZone configures the creation of a managed zone for the DNS record if no managed zone is found for its name.
+	// If it is not set, the reconciliation of the DNS record fails in this case.
+	// +optional
+	ManagedZone *DNSManagedZone `json:"managedZone,omitempty"`
+}
+
+// DNSManagedZoneVisibility is the visibility of a managed zone.
+type DNSManagedZoneVisibility string
+
+const (
+	// DNSManagedZoneVisibilityPublic is the visibility of managed zones which are exposed to the Internet.
+	DNSManagedZoneVisibilityPublic DNSManagedZoneVisibility = "public"
+	// DNSManagedZoneVisibilityPrivate is the visibility of managed zones which are only visible from VPC networks.
+	DNSManagedZoneVisibilityPrivate DNSManagedZoneVisibility = "private"
+)
+
+// DNSManagedZone contains the configuration of a managed zone which is created for a DNS record.
+type DNSManagedZone struct {
+	// DNSName is the DNS name of the managed zone, e.g. "example.com". It must be a suffix of the name of the DNS record.
+	DNSName string `json:"dnsName"`
+
+	// Visibility is the visibility of the managed zone.
+	// Currently allowed values are:
+	// - "public": The managed zone is exposed to the Internet.
+	// - "private": The managed zone is only visible from the configured VPC networks.
+	// Defaults to "public".
+	// +optional
+	Visibility *DNSManagedZoneVisibility `json:"visibility,omitempty"`
+
+	// Networks are the VPC networks a private managed zone is visible from. Networks are given by their names in the
+	// project of the managed zone, or by their full URLs.
+	// +optional
+	Networks []string `json:"networks,omitempty"`
+
+	// DNSSEC specifies whether DNSSEC is enabled for a public managed zone.
+	// +optional
+	DNSSEC *bool `json:"dnssec,omitempty"`
+}
+
+// DNSRecordTarget references a GCP resource whose IP address is resolved into the value of a DNS record.
+type DNSRecordTarget struct {
+	// ForwardingRule is the name of the forwarding rule of a load balancer in the project of the credentials.
+	ForwardingRule string `json:"forwardingRule"`
+
+	// Region is the region of a regional forwarding rule. If it is not set, the forwarding rule is a global one.
+	// +optional
+	Region *string `json:"region,omitempty"`
+}
+
+// DNSRoutingPolicyType is the type of a routing policy of a DNS record.
+type DNSRoutingPolicyType string
+
+const (
+	// DNSRoutingPolicyWeighted is the weighted round robin routing policy.
+	DNSRoutingPolicyWeighted DNSRoutingPolicyType = "weighted"
+	// DNSRoutingPolicyGeolocation is the geolocation routing policy.
+	DNSRoutingPolicyGeolocation DNSRoutingPolicyType = "geolocation"
+)
+
+// DNSRoutingPolicy contains the item of a routing policy which is managed by a DNS record.
+type DNSRoutingPolicy struct {
+	// Type is the type of the routing policy.
+	// Currently allowed values are:
+	// - "weighted": Traffic is distributed over the items according to their weights.
+	// - "geolocation": Traffic is routed to the item with the location closest to the origin of the request.
+	Type DNSRoutingPolicyType `json:"type"`
+
+	// Index is the position of the item in a weighted round robin routing policy.
+	// DNS records sharing the same resource record set must use distinct indices.
+	// +optional
+	Index *int32 `json:"index,omitempty"`
+
+	// Weight is the weight of the item in a weighted round robin routing policy. It must be at least 1, as items with
+	// weight 0 are placeholders for deleted items.
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+
+	// Location is the GCP region of the item in a geolocation routing policy, e.g. "europe-west1".
+	// +optional
+	Location *string `json:"location,omitempty"`
+}
@@ -43,6 +43,62 @@ type WorkerConfig struct {
 	// NodeTemplate contains resource information of the machine which is used by Cluster Autoscaler to generate nodeTemplate during scaling a nodeGroup from zero.
 	// +optional
 	NodeTemplate *extensionsv1alpha1.NodeTemplate `json:"nodeTemplate,omitempty"`
+
+	// Scheduling contains the host maintenance and restart behavior of the VMs.
+	// +optional
+	Scheduling *Scheduling `json:"scheduling,omitempty"`
+
+	// AdditionalNetworkInterfaces contains configuration for additional network interfaces attached to VMs.
+	// +optional
+	AdditionalNetworkInterfaces []AdditionalNetworkInterface `json:"additionalNetworkInterfaces,omitempty"`
+
+	// NodeBootstrap contains configuration for the GCP specific bootstrap of the nodes.
+	// +optional
+	NodeBootstrap *NodeBootstrap `json:"nodeBootstrap,omitempty"`
+}
+
+// NodeBootstrap contains configuration for the GCP specific bootstrap of the nodes.
+type NodeBootstrap struct {
+	// LocalSSD contains configuration for the formatting of the local NVMe SSDs attached to VMs.
+	// +optional
+	LocalSSD *LocalSSDBootstrap `json:"localSSD,omitempty"`
+
+	// ConcealMetadataServer prevents pods which do not use the host network from accessing the metadata server.
+	// +optional
+	ConcealMetadataServer *bool `json:"concealMetadataServer,omitempty"`
+}
+
+// LocalSSDBootstrap contains configuration for the formatting of the local NVMe SSDs attached to VMs.
+type LocalSSDBootstrap struct {
+	// MountPath is the path below /mnt/ at which the local NVMe SSDs are mounted. Multiple SSDs are combined into a
+	// RAID 0 array.
+	MountPath string `json:"mountPath"`
+}
+
+// AdditionalNetworkInterface contains configuration for an additional network interface attached to VMs.
+type AdditionalNetworkInterface struct {
+	// Network is the name of the VPC network the interface is attached to.
+	Network string `json:"network"`
+
+	// Subnetwork is the name of the subnetwork of the network the interface is attached to.
+	Subnetwork string `json:"subnetwork"`
+
+	// Routes is a list of destination CIDRs which are routed through this interface on the nodes.
+	// +optional
+	Routes []string `json:"routes,omitempty"`
+}
+
+// Scheduling contains the host maintenance and restart behavior of the VMs.
+type Scheduling struct {
+	// OnHostMaintenance is the behavior of the VM during host maintenance events. Either MIGRATE or TERMINATE.
+	// If not set, MIGRATE is used unless GPUs are attached to the VM, in which case TERMINATE is used.
+	// +optional
+	OnHostMaintenance *string `json:"onHostMaintenance,omitempty"`
+
+	// AutomaticRestart controls whether the VM is automatically restarted if it is terminated by Compute Engine.
+	// Defaults to true.
+	// +optional
+	AutomaticRestart *bool `json:"automaticRestart,omitempty"`
 }
 
 // Volume contains configuration for the disks attached to VMs.
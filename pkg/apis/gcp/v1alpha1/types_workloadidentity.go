@@ -20,4 +20,8 @@ type WorkloadIdentityConfig struct {
 	ProjectID string `json:"projectID,omitempty"`
 	// CredentialsConfig contains information for workload authentication against GCP.
 	CredentialsConfig *runtime.RawExtension `json:"credentialsConfig,omitempty"`
+	// QuotaProject is the ID of the GCP project which is used for the quota and billing of the API requests. It is
+	// required if the project of the federated identity differs from the project of the shoot.
+	// +optional
+	QuotaProject string `json:"quotaProject,omitempty"`
 }
@@ -15,6 +15,8 @@ import (
 	gcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -26,6 +28,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AdditionalNetworkInterface)(nil), (*gcp.AdditionalNetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdditionalNetworkInterface_To_gcp_AdditionalNetworkInterface(a.(*AdditionalNetworkInterface), b.(*gcp.AdditionalNetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.AdditionalNetworkInterface)(nil), (*AdditionalNetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_AdditionalNetworkInterface_To_v1alpha1_AdditionalNetworkInterface(a.(*gcp.AdditionalNetworkInterface), b.(*AdditionalNetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*gcp.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(a.(*BackupBucketConfig), b.(*gcp.BackupBucketConfig), scope)
 	}); err != nil {
@@ -36,6 +48,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CSIDriverControllerConfig)(nil), (*gcp.CSIDriverControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSIDriverControllerConfig_To_gcp_CSIDriverControllerConfig(a.(*CSIDriverControllerConfig), b.(*gcp.CSIDriverControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CSIDriverControllerConfig)(nil), (*CSIDriverControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CSIDriverControllerConfig_To_v1alpha1_CSIDriverControllerConfig(a.(*gcp.CSIDriverControllerConfig), b.(*CSIDriverControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CSIDriverNodeConfig)(nil), (*gcp.CSIDriverNodeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSIDriverNodeConfig_To_gcp_CSIDriverNodeConfig(a.(*CSIDriverNodeConfig), b.(*gcp.CSIDriverNodeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CSIDriverNodeConfig)(nil), (*CSIDriverNodeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CSIDriverNodeConfig_To_v1alpha1_CSIDriverNodeConfig(a.(*gcp.CSIDriverNodeConfig), b.(*CSIDriverNodeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CSISidecarConfig)(nil), (*gcp.CSISidecarConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSISidecarConfig_To_gcp_CSISidecarConfig(a.(*CSISidecarConfig), b.(*gcp.CSISidecarConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CSISidecarConfig)(nil), (*CSISidecarConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CSISidecarConfig_To_v1alpha1_CSISidecarConfig(a.(*gcp.CSISidecarConfig), b.(*CSISidecarConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*gcp.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*gcp.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -86,6 +128,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneStatus)(nil), (*gcp.ControlPlaneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControlPlaneStatus_To_gcp_ControlPlaneStatus(a.(*ControlPlaneStatus), b.(*gcp.ControlPlaneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.ControlPlaneStatus)(nil), (*ControlPlaneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(a.(*gcp.ControlPlaneStatus), b.(*ControlPlaneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSManagedZone)(nil), (*gcp.DNSManagedZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSManagedZone_To_gcp_DNSManagedZone(a.(*DNSManagedZone), b.(*gcp.DNSManagedZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSManagedZone)(nil), (*DNSManagedZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSManagedZone_To_v1alpha1_DNSManagedZone(a.(*gcp.DNSManagedZone), b.(*DNSManagedZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRecordConfig)(nil), (*gcp.DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig(a.(*DNSRecordConfig), b.(*gcp.DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSRecordConfig)(nil), (*DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(a.(*gcp.DNSRecordConfig), b.(*DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRecordTarget)(nil), (*gcp.DNSRecordTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRecordTarget_To_gcp_DNSRecordTarget(a.(*DNSRecordTarget), b.(*gcp.DNSRecordTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSRecordTarget)(nil), (*DNSRecordTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSRecordTarget_To_v1alpha1_DNSRecordTarget(a.(*gcp.DNSRecordTarget), b.(*DNSRecordTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRoutingPolicy)(nil), (*gcp.DNSRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy(a.(*DNSRoutingPolicy), b.(*gcp.DNSRoutingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSRoutingPolicy)(nil), (*DNSRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy(a.(*gcp.DNSRoutingPolicy), b.(*DNSRoutingPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*gcp.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DataVolume_To_gcp_DataVolume(a.(*DataVolume), b.(*gcp.DataVolume), scope)
 	}); err != nil {
@@ -176,6 +268,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*IngressGCEConfig)(nil), (*gcp.IngressGCEConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IngressGCEConfig_To_gcp_IngressGCEConfig(a.(*IngressGCEConfig), b.(*gcp.IngressGCEConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.IngressGCEConfig)(nil), (*IngressGCEConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_IngressGCEConfig_To_v1alpha1_IngressGCEConfig(a.(*gcp.IngressGCEConfig), b.(*IngressGCEConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KMSConfig)(nil), (*gcp.KMSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KMSConfig_To_gcp_KMSConfig(a.(*KMSConfig), b.(*gcp.KMSConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.KMSConfig)(nil), (*KMSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_KMSConfig_To_v1alpha1_KMSConfig(a.(*gcp.KMSConfig), b.(*KMSConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfig)(nil), (*gcp.LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfig_To_gcp_LoadBalancerConfig(a.(*LoadBalancerConfig), b.(*gcp.LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.LoadBalancerConfig)(nil), (*LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(a.(*gcp.LoadBalancerConfig), b.(*LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LocalSSDBootstrap)(nil), (*gcp.LocalSSDBootstrap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LocalSSDBootstrap_To_gcp_LocalSSDBootstrap(a.(*LocalSSDBootstrap), b.(*gcp.LocalSSDBootstrap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.LocalSSDBootstrap)(nil), (*LocalSSDBootstrap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_LocalSSDBootstrap_To_v1alpha1_LocalSSDBootstrap(a.(*gcp.LocalSSDBootstrap), b.(*LocalSSDBootstrap), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*gcp.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_gcp_MachineImage(a.(*MachineImage), b.(*gcp.MachineImage), scope)
 	}); err != nil {
@@ -246,6 +378,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeBootstrap)(nil), (*gcp.NodeBootstrap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeBootstrap_To_gcp_NodeBootstrap(a.(*NodeBootstrap), b.(*gcp.NodeBootstrap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NodeBootstrap)(nil), (*NodeBootstrap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NodeBootstrap_To_v1alpha1_NodeBootstrap(a.(*gcp.NodeBootstrap), b.(*NodeBootstrap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Scheduling)(nil), (*gcp.Scheduling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Scheduling_To_gcp_Scheduling(a.(*Scheduling), b.(*gcp.Scheduling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.Scheduling)(nil), (*Scheduling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_Scheduling_To_v1alpha1_Scheduling(a.(*gcp.Scheduling), b.(*Scheduling), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ServiceAccount)(nil), (*gcp.ServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ServiceAccount_To_gcp_ServiceAccount(a.(*ServiceAccount), b.(*gcp.ServiceAccount), scope)
 	}); err != nil {
@@ -266,6 +418,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*gcp.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClass_To_gcp_StorageClass(a.(*StorageClass), b.(*gcp.StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.StorageClass)(nil), (*StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_StorageClass_To_v1alpha1_StorageClass(a.(*gcp.StorageClass), b.(*StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StoragePool)(nil), (*gcp.StoragePool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StoragePool_To_gcp_StoragePool(a.(*StoragePool), b.(*gcp.StoragePool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.StoragePool)(nil), (*StoragePool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_StoragePool_To_v1alpha1_StoragePool(a.(*gcp.StoragePool), b.(*StoragePool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageTopology)(nil), (*gcp.StorageTopology)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageTopology_To_gcp_StorageTopology(a.(*StorageTopology), b.(*gcp.StorageTopology), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.StorageTopology)(nil), (*StorageTopology)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_StorageTopology_To_v1alpha1_StorageTopology(a.(*gcp.StorageTopology), b.(*StorageTopology), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*gcp.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Subnet_To_gcp_Subnet(a.(*Subnet), b.(*gcp.Subnet), scope)
 	}); err != nil {
@@ -296,6 +478,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VolumeSnapshotClass)(nil), (*gcp.VolumeSnapshotClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotClass_To_gcp_VolumeSnapshotClass(a.(*VolumeSnapshotClass), b.(*gcp.VolumeSnapshotClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.VolumeSnapshotClass)(nil), (*VolumeSnapshotClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass(a.(*gcp.VolumeSnapshotClass), b.(*VolumeSnapshotClass), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*gcp.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(a.(*WorkerConfig), b.(*gcp.WorkerConfig), scope)
 	}); err != nil {
@@ -329,6 +521,30 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_AdditionalNetworkInterface_To_gcp_AdditionalNetworkInterface(in *AdditionalNetworkInterface, out *gcp.AdditionalNetworkInterface, s conversion.Scope) error {
+	out.Network = in.Network
+	out.Subnetwork = in.Subnetwork
+	out.Routes = *(*[]string)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_v1alpha1_AdditionalNetworkInterface_To_gcp_AdditionalNetworkInterface is an autogenerated conversion function.
+func Convert_v1alpha1_AdditionalNetworkInterface_To_gcp_AdditionalNetworkInterface(in *AdditionalNetworkInterface, out *gcp.AdditionalNetworkInterface, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdditionalNetworkInterface_To_gcp_AdditionalNetworkInterface(in, out, s)
+}
+
+func autoConvert_gcp_AdditionalNetworkInterface_To_v1alpha1_AdditionalNetworkInterface(in *gcp.AdditionalNetworkInterface, out *AdditionalNetworkInterface, s conversion.Scope) error {
+	out.Network = in.Network
+	out.Subnetwork = in.Subnetwork
+	out.Routes = *(*[]string)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_gcp_AdditionalNetworkInterface_To_v1alpha1_AdditionalNetworkInterface is an autogenerated conversion function.
+func Convert_gcp_AdditionalNetworkInterface_To_v1alpha1_AdditionalNetworkInterface(in *gcp.AdditionalNetworkInterface, out *AdditionalNetworkInterface, s conversion.Scope) error {
+	return autoConvert_gcp_AdditionalNetworkInterface_To_v1alpha1_AdditionalNetworkInterface(in, out, s)
+}
+
 func autoConvert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(in *BackupBucketConfig, out *gcp.BackupBucketConfig, s conversion.Scope) error {
 	out.Immutability = (*gcp.ImmutableConfig)(unsafe.Pointer(in.Immutability))
 	return nil
@@ -349,8 +565,90 @@ func Convert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *gcp.Backu
 	return autoConvert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_CSIDriverControllerConfig_To_gcp_CSIDriverControllerConfig(in *CSIDriverControllerConfig, out *gcp.CSIDriverControllerConfig, s conversion.Scope) error {
+	out.Provisioner = (*gcp.CSISidecarConfig)(unsafe.Pointer(in.Provisioner))
+	out.Attacher = (*gcp.CSISidecarConfig)(unsafe.Pointer(in.Attacher))
+	out.Resizer = (*gcp.CSISidecarConfig)(unsafe.Pointer(in.Resizer))
+	out.MetricsEnabled = (*bool)(unsafe.Pointer(in.MetricsEnabled))
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.SnapshotsEnabled = (*bool)(unsafe.Pointer(in.SnapshotsEnabled))
+	out.ResizerEnabled = (*bool)(unsafe.Pointer(in.ResizerEnabled))
+	return nil
+}
+
+// Convert_v1alpha1_CSIDriverControllerConfig_To_gcp_CSIDriverControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CSIDriverControllerConfig_To_gcp_CSIDriverControllerConfig(in *CSIDriverControllerConfig, out *gcp.CSIDriverControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSIDriverControllerConfig_To_gcp_CSIDriverControllerConfig(in, out, s)
+}
+
+func autoConvert_gcp_CSIDriverControllerConfig_To_v1alpha1_CSIDriverControllerConfig(in *gcp.CSIDriverControllerConfig, out *CSIDriverControllerConfig, s conversion.Scope) error {
+	out.Provisioner = (*CSISidecarConfig)(unsafe.Pointer(in.Provisioner))
+	out.Attacher = (*CSISidecarConfig)(unsafe.Pointer(in.Attacher))
+	out.Resizer = (*CSISidecarConfig)(unsafe.Pointer(in.Resizer))
+	out.MetricsEnabled = (*bool)(unsafe.Pointer(in.MetricsEnabled))
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.SnapshotsEnabled = (*bool)(unsafe.Pointer(in.SnapshotsEnabled))
+	out.ResizerEnabled = (*bool)(unsafe.Pointer(in.ResizerEnabled))
+	return nil
+}
+
+// Convert_gcp_CSIDriverControllerConfig_To_v1alpha1_CSIDriverControllerConfig is an autogenerated conversion function.
+func Convert_gcp_CSIDriverControllerConfig_To_v1alpha1_CSIDriverControllerConfig(in *gcp.CSIDriverControllerConfig, out *CSIDriverControllerConfig, s conversion.Scope) error {
+	return autoConvert_gcp_CSIDriverControllerConfig_To_v1alpha1_CSIDriverControllerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CSIDriverNodeConfig_To_gcp_CSIDriverNodeConfig(in *CSIDriverNodeConfig, out *gcp.CSIDriverNodeConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_v1alpha1_CSIDriverNodeConfig_To_gcp_CSIDriverNodeConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CSIDriverNodeConfig_To_gcp_CSIDriverNodeConfig(in *CSIDriverNodeConfig, out *gcp.CSIDriverNodeConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSIDriverNodeConfig_To_gcp_CSIDriverNodeConfig(in, out, s)
+}
+
+func autoConvert_gcp_CSIDriverNodeConfig_To_v1alpha1_CSIDriverNodeConfig(in *gcp.CSIDriverNodeConfig, out *CSIDriverNodeConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_gcp_CSIDriverNodeConfig_To_v1alpha1_CSIDriverNodeConfig is an autogenerated conversion function.
+func Convert_gcp_CSIDriverNodeConfig_To_v1alpha1_CSIDriverNodeConfig(in *gcp.CSIDriverNodeConfig, out *CSIDriverNodeConfig, s conversion.Scope) error {
+	return autoConvert_gcp_CSIDriverNodeConfig_To_v1alpha1_CSIDriverNodeConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CSISidecarConfig_To_gcp_CSISidecarConfig(in *CSISidecarConfig, out *gcp.CSISidecarConfig, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Workers = (*int32)(unsafe.Pointer(in.Workers))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_CSISidecarConfig_To_gcp_CSISidecarConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CSISidecarConfig_To_gcp_CSISidecarConfig(in *CSISidecarConfig, out *gcp.CSISidecarConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSISidecarConfig_To_gcp_CSISidecarConfig(in, out, s)
+}
+
+func autoConvert_gcp_CSISidecarConfig_To_v1alpha1_CSISidecarConfig(in *gcp.CSISidecarConfig, out *CSISidecarConfig, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Workers = (*int32)(unsafe.Pointer(in.Workers))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_gcp_CSISidecarConfig_To_v1alpha1_CSISidecarConfig is an autogenerated conversion function.
+func Convert_gcp_CSISidecarConfig_To_v1alpha1_CSISidecarConfig(in *gcp.CSISidecarConfig, out *CSISidecarConfig, s conversion.Scope) error {
+	return autoConvert_gcp_CSISidecarConfig_To_v1alpha1_CSISidecarConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *gcp.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.Flags = *(*map[string]string)(unsafe.Pointer(&in.Flags))
+	out.NodeIPAMMode = (*string)(unsafe.Pointer(in.NodeIPAMMode))
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
 	return nil
 }
 
@@ -361,6 +659,10 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManager
 
 func autoConvert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *gcp.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.Flags = *(*map[string]string)(unsafe.Pointer(&in.Flags))
+	out.NodeIPAMMode = (*string)(unsafe.Pointer(in.NodeIPAMMode))
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
 	return nil
 }
 
@@ -451,6 +753,11 @@ func autoConvert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *Contr
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*gcp.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
 	out.Storage = (*gcp.Storage)(unsafe.Pointer(in.Storage))
+	out.CSIDriverController = (*gcp.CSIDriverControllerConfig)(unsafe.Pointer(in.CSIDriverController))
+	out.CSIDriverNode = (*gcp.CSIDriverNodeConfig)(unsafe.Pointer(in.CSIDriverNode))
+	out.IngressGCE = (*gcp.IngressGCEConfig)(unsafe.Pointer(in.IngressGCE))
+	out.LoadBalancer = (*gcp.LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.KMS = (*gcp.KMSConfig)(unsafe.Pointer(in.KMS))
 	return nil
 }
 
@@ -463,6 +770,11 @@ func autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.C
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
 	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+	out.CSIDriverController = (*CSIDriverControllerConfig)(unsafe.Pointer(in.CSIDriverController))
+	out.CSIDriverNode = (*CSIDriverNodeConfig)(unsafe.Pointer(in.CSIDriverNode))
+	out.IngressGCE = (*IngressGCEConfig)(unsafe.Pointer(in.IngressGCE))
+	out.LoadBalancer = (*LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.KMS = (*KMSConfig)(unsafe.Pointer(in.KMS))
 	return nil
 }
 
@@ -471,6 +783,126 @@ func Convert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.Contr
 	return autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControlPlaneStatus_To_gcp_ControlPlaneStatus(in *ControlPlaneStatus, out *gcp.ControlPlaneStatus, s conversion.Scope) error {
+	out.StoragePools = *(*[]string)(unsafe.Pointer(&in.StoragePools))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlaneStatus_To_gcp_ControlPlaneStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlaneStatus_To_gcp_ControlPlaneStatus(in *ControlPlaneStatus, out *gcp.ControlPlaneStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlaneStatus_To_gcp_ControlPlaneStatus(in, out, s)
+}
+
+func autoConvert_gcp_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(in *gcp.ControlPlaneStatus, out *ControlPlaneStatus, s conversion.Scope) error {
+	out.StoragePools = *(*[]string)(unsafe.Pointer(&in.StoragePools))
+	return nil
+}
+
+// Convert_gcp_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus is an autogenerated conversion function.
+func Convert_gcp_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(in *gcp.ControlPlaneStatus, out *ControlPlaneStatus, s conversion.Scope) error {
+	return autoConvert_gcp_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSManagedZone_To_gcp_DNSManagedZone(in *DNSManagedZone, out *gcp.DNSManagedZone, s conversion.Scope) error {
+	out.DNSName = in.DNSName
+	out.Visibility = (*gcp.DNSManagedZoneVisibility)(unsafe.Pointer(in.Visibility))
+	out.Networks = *(*[]string)(unsafe.Pointer(&in.Networks))
+	out.DNSSEC = (*bool)(unsafe.Pointer(in.DNSSEC))
+	return nil
+}
+
+// Convert_v1alpha1_DNSManagedZone_To_gcp_DNSManagedZone is an autogenerated conversion function.
+func Convert_v1alpha1_DNSManagedZone_To_gcp_DNSManagedZone(in *DNSManagedZone, out *gcp.DNSManagedZone, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSManagedZone_To_gcp_DNSManagedZone(in, out, s)
+}
+
+func autoConvert_gcp_DNSManagedZone_To_v1alpha1_DNSManagedZone(in *gcp.DNSManagedZone, out *DNSManagedZone, s conversion.Scope) error {
+	out.DNSName = in.DNSName
+	out.Visibility = (*DNSManagedZoneVisibility)(unsafe.Pointer(in.Visibility))
+	out.Networks = *(*[]string)(unsafe.Pointer(&in.Networks))
+	out.DNSSEC = (*bool)(unsafe.Pointer(in.DNSSEC))
+	return nil
+}
+
+// Convert_gcp_DNSManagedZone_To_v1alpha1_DNSManagedZone is an autogenerated conversion function.
+func Convert_gcp_DNSManagedZone_To_v1alpha1_DNSManagedZone(in *gcp.DNSManagedZone, out *DNSManagedZone, s conversion.Scope) error {
+	return autoConvert_gcp_DNSManagedZone_To_v1alpha1_DNSManagedZone(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig(in *DNSRecordConfig, out *gcp.DNSRecordConfig, s conversion.Scope) error {
+	out.RoutingPolicy = (*gcp.DNSRoutingPolicy)(unsafe.Pointer(in.RoutingPolicy))
+	out.ProjectID = (*string)(unsafe.Pointer(in.ProjectID))
+	out.Target = (*gcp.DNSRecordTarget)(unsafe.Pointer(in.Target))
+	out.ManagedZone = (*gcp.DNSManagedZone)(unsafe.Pointer(in.ManagedZone))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig(in *DNSRecordConfig, out *gcp.DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *gcp.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	out.RoutingPolicy = (*DNSRoutingPolicy)(unsafe.Pointer(in.RoutingPolicy))
+	out.ProjectID = (*string)(unsafe.Pointer(in.ProjectID))
+	out.Target = (*DNSRecordTarget)(unsafe.Pointer(in.Target))
+	out.ManagedZone = (*DNSManagedZone)(unsafe.Pointer(in.ManagedZone))
+	return nil
+}
+
+// Convert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig is an autogenerated conversion function.
+func Convert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *gcp.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRecordTarget_To_gcp_DNSRecordTarget(in *DNSRecordTarget, out *gcp.DNSRecordTarget, s conversion.Scope) error {
+	out.ForwardingRule = in.ForwardingRule
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRecordTarget_To_gcp_DNSRecordTarget is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRecordTarget_To_gcp_DNSRecordTarget(in *DNSRecordTarget, out *gcp.DNSRecordTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRecordTarget_To_gcp_DNSRecordTarget(in, out, s)
+}
+
+func autoConvert_gcp_DNSRecordTarget_To_v1alpha1_DNSRecordTarget(in *gcp.DNSRecordTarget, out *DNSRecordTarget, s conversion.Scope) error {
+	out.ForwardingRule = in.ForwardingRule
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	return nil
+}
+
+// Convert_gcp_DNSRecordTarget_To_v1alpha1_DNSRecordTarget is an autogenerated conversion function.
+func Convert_gcp_DNSRecordTarget_To_v1alpha1_DNSRecordTarget(in *gcp.DNSRecordTarget, out *DNSRecordTarget, s conversion.Scope) error {
+	return autoConvert_gcp_DNSRecordTarget_To_v1alpha1_DNSRecordTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy(in *DNSRoutingPolicy, out *gcp.DNSRoutingPolicy, s conversion.Scope) error {
+	out.Type = gcp.DNSRoutingPolicyType(in.Type)
+	out.Index = (*int32)(unsafe.Pointer(in.Index))
+	out.Weight = (*int64)(unsafe.Pointer(in.Weight))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy(in *DNSRoutingPolicy, out *gcp.DNSRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy(in, out, s)
+}
+
+func autoConvert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy(in *gcp.DNSRoutingPolicy, out *DNSRoutingPolicy, s conversion.Scope) error {
+	out.Type = DNSRoutingPolicyType(in.Type)
+	out.Index = (*int32)(unsafe.Pointer(in.Index))
+	out.Weight = (*int64)(unsafe.Pointer(in.Weight))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	return nil
+}
+
+// Convert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy is an autogenerated conversion function.
+func Convert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy(in *gcp.DNSRoutingPolicy, out *DNSRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy(in, out, s)
+}
+
 func autoConvert_v1alpha1_DataVolume_To_gcp_DataVolume(in *DataVolume, out *gcp.DataVolume, s conversion.Scope) error {
 	out.Name = in.Name
 	out.SourceImage = (*string)(unsafe.Pointer(in.SourceImage))
@@ -691,6 +1123,96 @@ func Convert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *gcp.I
 	return autoConvert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_IngressGCEConfig_To_gcp_IngressGCEConfig(in *IngressGCEConfig, out *gcp.IngressGCEConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_v1alpha1_IngressGCEConfig_To_gcp_IngressGCEConfig is an autogenerated conversion function.
+func Convert_v1alpha1_IngressGCEConfig_To_gcp_IngressGCEConfig(in *IngressGCEConfig, out *gcp.IngressGCEConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IngressGCEConfig_To_gcp_IngressGCEConfig(in, out, s)
+}
+
+func autoConvert_gcp_IngressGCEConfig_To_v1alpha1_IngressGCEConfig(in *gcp.IngressGCEConfig, out *IngressGCEConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_gcp_IngressGCEConfig_To_v1alpha1_IngressGCEConfig is an autogenerated conversion function.
+func Convert_gcp_IngressGCEConfig_To_v1alpha1_IngressGCEConfig(in *gcp.IngressGCEConfig, out *IngressGCEConfig, s conversion.Scope) error {
+	return autoConvert_gcp_IngressGCEConfig_To_v1alpha1_IngressGCEConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_KMSConfig_To_gcp_KMSConfig(in *KMSConfig, out *gcp.KMSConfig, s conversion.Scope) error {
+	out.KeyName = in.KeyName
+	return nil
+}
+
+// Convert_v1alpha1_KMSConfig_To_gcp_KMSConfig is an autogenerated conversion function.
+func Convert_v1alpha1_KMSConfig_To_gcp_KMSConfig(in *KMSConfig, out *gcp.KMSConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KMSConfig_To_gcp_KMSConfig(in, out, s)
+}
+
+func autoConvert_gcp_KMSConfig_To_v1alpha1_KMSConfig(in *gcp.KMSConfig, out *KMSConfig, s conversion.Scope) error {
+	out.KeyName = in.KeyName
+	return nil
+}
+
+// Convert_gcp_KMSConfig_To_v1alpha1_KMSConfig is an autogenerated conversion function.
+func Convert_gcp_KMSConfig_To_v1alpha1_KMSConfig(in *gcp.KMSConfig, out *KMSConfig, s conversion.Scope) error {
+	return autoConvert_gcp_KMSConfig_To_v1alpha1_KMSConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerConfig_To_gcp_LoadBalancerConfig(in *LoadBalancerConfig, out *gcp.LoadBalancerConfig, s conversion.Scope) error {
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.GlobalAccess = (*bool)(unsafe.Pointer(in.GlobalAccess))
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.NetworkTier = (*string)(unsafe.Pointer(in.NetworkTier))
+	out.SourceRanges = *(*[]string)(unsafe.Pointer(&in.SourceRanges))
+	out.FirewallTargetTags = *(*[]string)(unsafe.Pointer(&in.FirewallTargetTags))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfig_To_gcp_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfig_To_gcp_LoadBalancerConfig(in *LoadBalancerConfig, out *gcp.LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfig_To_gcp_LoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_gcp_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *gcp.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.GlobalAccess = (*bool)(unsafe.Pointer(in.GlobalAccess))
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.NetworkTier = (*string)(unsafe.Pointer(in.NetworkTier))
+	out.SourceRanges = *(*[]string)(unsafe.Pointer(&in.SourceRanges))
+	out.FirewallTargetTags = *(*[]string)(unsafe.Pointer(&in.FirewallTargetTags))
+	return nil
+}
+
+// Convert_gcp_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_gcp_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *gcp.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_gcp_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LocalSSDBootstrap_To_gcp_LocalSSDBootstrap(in *LocalSSDBootstrap, out *gcp.LocalSSDBootstrap, s conversion.Scope) error {
+	out.MountPath = in.MountPath
+	return nil
+}
+
+// Convert_v1alpha1_LocalSSDBootstrap_To_gcp_LocalSSDBootstrap is an autogenerated conversion function.
+func Convert_v1alpha1_LocalSSDBootstrap_To_gcp_LocalSSDBootstrap(in *LocalSSDBootstrap, out *gcp.LocalSSDBootstrap, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LocalSSDBootstrap_To_gcp_LocalSSDBootstrap(in, out, s)
+}
+
+func autoConvert_gcp_LocalSSDBootstrap_To_v1alpha1_LocalSSDBootstrap(in *gcp.LocalSSDBootstrap, out *LocalSSDBootstrap, s conversion.Scope) error {
+	out.MountPath = in.MountPath
+	return nil
+}
+
+// Convert_gcp_LocalSSDBootstrap_To_v1alpha1_LocalSSDBootstrap is an autogenerated conversion function.
+func Convert_gcp_LocalSSDBootstrap_To_v1alpha1_LocalSSDBootstrap(in *gcp.LocalSSDBootstrap, out *LocalSSDBootstrap, s conversion.Scope) error {
+	return autoConvert_gcp_LocalSSDBootstrap_To_v1alpha1_LocalSSDBootstrap(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_gcp_MachineImage(in *MachineImage, out *gcp.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -879,6 +1401,50 @@ func Convert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in *gcp.NetworkStatus,
 	return autoConvert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_NodeBootstrap_To_gcp_NodeBootstrap(in *NodeBootstrap, out *gcp.NodeBootstrap, s conversion.Scope) error {
+	out.LocalSSD = (*gcp.LocalSSDBootstrap)(unsafe.Pointer(in.LocalSSD))
+	out.ConcealMetadataServer = (*bool)(unsafe.Pointer(in.ConcealMetadataServer))
+	return nil
+}
+
+// Convert_v1alpha1_NodeBootstrap_To_gcp_NodeBootstrap is an autogenerated conversion function.
+func Convert_v1alpha1_NodeBootstrap_To_gcp_NodeBootstrap(in *NodeBootstrap, out *gcp.NodeBootstrap, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeBootstrap_To_gcp_NodeBootstrap(in, out, s)
+}
+
+func autoConvert_gcp_NodeBootstrap_To_v1alpha1_NodeBootstrap(in *gcp.NodeBootstrap, out *NodeBootstrap, s conversion.Scope) error {
+	out.LocalSSD = (*LocalSSDBootstrap)(unsafe.Pointer(in.LocalSSD))
+	out.ConcealMetadataServer = (*bool)(unsafe.Pointer(in.ConcealMetadataServer))
+	return nil
+}
+
+// Convert_gcp_NodeBootstrap_To_v1alpha1_NodeBootstrap is an autogenerated conversion function.
+func Convert_gcp_NodeBootstrap_To_v1alpha1_NodeBootstrap(in *gcp.NodeBootstrap, out *NodeBootstrap, s conversion.Scope) error {
+	return autoConvert_gcp_NodeBootstrap_To_v1alpha1_NodeBootstrap(in, out, s)
+}
+
+func autoConvert_v1alpha1_Scheduling_To_gcp_Scheduling(in *Scheduling, out *gcp.Scheduling, s conversion.Scope) error {
+	out.OnHostMaintenance = (*string)(unsafe.Pointer(in.OnHostMaintenance))
+	out.AutomaticRestart = (*bool)(unsafe.Pointer(in.AutomaticRestart))
+	return nil
+}
+
+// Convert_v1alpha1_Scheduling_To_gcp_Scheduling is an autogenerated conversion function.
+func Convert_v1alpha1_Scheduling_To_gcp_Scheduling(in *Scheduling, out *gcp.Scheduling, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Scheduling_To_gcp_Scheduling(in, out, s)
+}
+
+func autoConvert_gcp_Scheduling_To_v1alpha1_Scheduling(in *gcp.Scheduling, out *Scheduling, s conversion.Scope) error {
+	out.OnHostMaintenance = (*string)(unsafe.Pointer(in.OnHostMaintenance))
+	out.AutomaticRestart = (*bool)(unsafe.Pointer(in.AutomaticRestart))
+	return nil
+}
+
+// Convert_gcp_Scheduling_To_v1alpha1_Scheduling is an autogenerated conversion function.
+func Convert_gcp_Scheduling_To_v1alpha1_Scheduling(in *gcp.Scheduling, out *Scheduling, s conversion.Scope) error {
+	return autoConvert_gcp_Scheduling_To_v1alpha1_Scheduling(in, out, s)
+}
+
 func autoConvert_v1alpha1_ServiceAccount_To_gcp_ServiceAccount(in *ServiceAccount, out *gcp.ServiceAccount, s conversion.Scope) error {
 	out.Email = in.Email
 	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
@@ -904,6 +1470,11 @@ func Convert_gcp_ServiceAccount_To_v1alpha1_ServiceAccount(in *gcp.ServiceAccoun
 func autoConvert_v1alpha1_Storage_To_gcp_Storage(in *Storage, out *gcp.Storage, s conversion.Scope) error {
 	out.ManagedDefaultStorageClass = (*bool)(unsafe.Pointer(in.ManagedDefaultStorageClass))
 	out.ManagedDefaultVolumeSnapshotClass = (*bool)(unsafe.Pointer(in.ManagedDefaultVolumeSnapshotClass))
+	out.AllowVolumeExpansion = (*bool)(unsafe.Pointer(in.AllowVolumeExpansion))
+	out.VolumeSnapshotClass = (*gcp.VolumeSnapshotClass)(unsafe.Pointer(in.VolumeSnapshotClass))
+	out.StorageClasses = *(*[]gcp.StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.StoragePools = *(*[]gcp.StoragePool)(unsafe.Pointer(&in.StoragePools))
+	out.Topology = (*gcp.StorageTopology)(unsafe.Pointer(in.Topology))
 	return nil
 }
 
@@ -915,6 +1486,11 @@ func Convert_v1alpha1_Storage_To_gcp_Storage(in *Storage, out *gcp.Storage, s co
 func autoConvert_gcp_Storage_To_v1alpha1_Storage(in *gcp.Storage, out *Storage, s conversion.Scope) error {
 	out.ManagedDefaultStorageClass = (*bool)(unsafe.Pointer(in.ManagedDefaultStorageClass))
 	out.ManagedDefaultVolumeSnapshotClass = (*bool)(unsafe.Pointer(in.ManagedDefaultVolumeSnapshotClass))
+	out.AllowVolumeExpansion = (*bool)(unsafe.Pointer(in.AllowVolumeExpansion))
+	out.VolumeSnapshotClass = (*VolumeSnapshotClass)(unsafe.Pointer(in.VolumeSnapshotClass))
+	out.StorageClasses = *(*[]StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.StoragePools = *(*[]StoragePool)(unsafe.Pointer(&in.StoragePools))
+	out.Topology = (*StorageTopology)(unsafe.Pointer(in.Topology))
 	return nil
 }
 
@@ -923,6 +1499,92 @@ func Convert_gcp_Storage_To_v1alpha1_Storage(in *gcp.Storage, out *Storage, s co
 	return autoConvert_gcp_Storage_To_v1alpha1_Storage(in, out, s)
 }
 
+func autoConvert_v1alpha1_StorageClass_To_gcp_StorageClass(in *StorageClass, out *gcp.StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.ReplicationType = (*string)(unsafe.Pointer(in.ReplicationType))
+	out.ProvisionedIops = (*int64)(unsafe.Pointer(in.ProvisionedIops))
+	out.ProvisionedThroughput = (*int64)(unsafe.Pointer(in.ProvisionedThroughput))
+	out.StoragePools = *(*[]string)(unsafe.Pointer(&in.StoragePools))
+	return nil
+}
+
+// Convert_v1alpha1_StorageClass_To_gcp_StorageClass is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClass_To_gcp_StorageClass(in *StorageClass, out *gcp.StorageClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClass_To_gcp_StorageClass(in, out, s)
+}
+
+func autoConvert_gcp_StorageClass_To_v1alpha1_StorageClass(in *gcp.StorageClass, out *StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.ReplicationType = (*string)(unsafe.Pointer(in.ReplicationType))
+	out.ProvisionedIops = (*int64)(unsafe.Pointer(in.ProvisionedIops))
+	out.ProvisionedThroughput = (*int64)(unsafe.Pointer(in.ProvisionedThroughput))
+	out.StoragePools = *(*[]string)(unsafe.Pointer(&in.StoragePools))
+	return nil
+}
+
+// Convert_gcp_StorageClass_To_v1alpha1_StorageClass is an autogenerated conversion function.
+func Convert_gcp_StorageClass_To_v1alpha1_StorageClass(in *gcp.StorageClass, out *StorageClass, s conversion.Scope) error {
+	return autoConvert_gcp_StorageClass_To_v1alpha1_StorageClass(in, out, s)
+}
+
+func autoConvert_v1alpha1_StoragePool_To_gcp_StoragePool(in *StoragePool, out *gcp.StoragePool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Zone = in.Zone
+	out.Type = in.Type
+	out.ProvisionedCapacity = in.ProvisionedCapacity
+	out.ProvisionedIops = (*int64)(unsafe.Pointer(in.ProvisionedIops))
+	out.ProvisionedThroughput = in.ProvisionedThroughput
+	return nil
+}
+
+// Convert_v1alpha1_StoragePool_To_gcp_StoragePool is an autogenerated conversion function.
+func Convert_v1alpha1_StoragePool_To_gcp_StoragePool(in *StoragePool, out *gcp.StoragePool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StoragePool_To_gcp_StoragePool(in, out, s)
+}
+
+func autoConvert_gcp_StoragePool_To_v1alpha1_StoragePool(in *gcp.StoragePool, out *StoragePool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Zone = in.Zone
+	out.Type = in.Type
+	out.ProvisionedCapacity = in.ProvisionedCapacity
+	out.ProvisionedIops = (*int64)(unsafe.Pointer(in.ProvisionedIops))
+	out.ProvisionedThroughput = in.ProvisionedThroughput
+	return nil
+}
+
+// Convert_gcp_StoragePool_To_v1alpha1_StoragePool is an autogenerated conversion function.
+func Convert_gcp_StoragePool_To_v1alpha1_StoragePool(in *gcp.StoragePool, out *StoragePool, s conversion.Scope) error {
+	return autoConvert_gcp_StoragePool_To_v1alpha1_StoragePool(in, out, s)
+}
+
+func autoConvert_v1alpha1_StorageTopology_To_gcp_StorageTopology(in *StorageTopology, out *gcp.StorageTopology, s conversion.Scope) error {
+	out.AllowedZones = *(*[]string)(unsafe.Pointer(&in.AllowedZones))
+	out.VolumeBindingMode = (*string)(unsafe.Pointer(in.VolumeBindingMode))
+	out.StrictTopology = (*bool)(unsafe.Pointer(in.StrictTopology))
+	return nil
+}
+
+// Convert_v1alpha1_StorageTopology_To_gcp_StorageTopology is an autogenerated conversion function.
+func Convert_v1alpha1_StorageTopology_To_gcp_StorageTopology(in *StorageTopology, out *gcp.StorageTopology, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageTopology_To_gcp_StorageTopology(in, out, s)
+}
+
+func autoConvert_gcp_StorageTopology_To_v1alpha1_StorageTopology(in *gcp.StorageTopology, out *StorageTopology, s conversion.Scope) error {
+	out.AllowedZones = *(*[]string)(unsafe.Pointer(&in.AllowedZones))
+	out.VolumeBindingMode = (*string)(unsafe.Pointer(in.VolumeBindingMode))
+	out.StrictTopology = (*bool)(unsafe.Pointer(in.StrictTopology))
+	return nil
+}
+
+// Convert_gcp_StorageTopology_To_v1alpha1_StorageTopology is an autogenerated conversion function.
+func Convert_gcp_StorageTopology_To_v1alpha1_StorageTopology(in *gcp.StorageTopology, out *StorageTopology, s conversion.Scope) error {
+	return autoConvert_gcp_StorageTopology_To_v1alpha1_StorageTopology(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_gcp_Subnet(in *Subnet, out *gcp.Subnet, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Purpose = gcp.SubnetPurpose(in.Purpose)
@@ -989,6 +1651,32 @@ func Convert_gcp_Volume_To_v1alpha1_Volume(in *gcp.Volume, out *Volume, s conver
 	return autoConvert_gcp_Volume_To_v1alpha1_Volume(in, out, s)
 }
 
+func autoConvert_v1alpha1_VolumeSnapshotClass_To_gcp_VolumeSnapshotClass(in *VolumeSnapshotClass, out *gcp.VolumeSnapshotClass, s conversion.Scope) error {
+	out.SnapshotType = (*string)(unsafe.Pointer(in.SnapshotType))
+	out.StorageLocation = (*string)(unsafe.Pointer(in.StorageLocation))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.DeletionPolicy = (*string)(unsafe.Pointer(in.DeletionPolicy))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotClass_To_gcp_VolumeSnapshotClass is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotClass_To_gcp_VolumeSnapshotClass(in *VolumeSnapshotClass, out *gcp.VolumeSnapshotClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotClass_To_gcp_VolumeSnapshotClass(in, out, s)
+}
+
+func autoConvert_gcp_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass(in *gcp.VolumeSnapshotClass, out *VolumeSnapshotClass, s conversion.Scope) error {
+	out.SnapshotType = (*string)(unsafe.Pointer(in.SnapshotType))
+	out.StorageLocation = (*string)(unsafe.Pointer(in.StorageLocation))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.DeletionPolicy = (*string)(unsafe.Pointer(in.DeletionPolicy))
+	return nil
+}
+
+// Convert_gcp_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass is an autogenerated conversion function.
+func Convert_gcp_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass(in *gcp.VolumeSnapshotClass, out *VolumeSnapshotClass, s conversion.Scope) error {
+	return autoConvert_gcp_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in *WorkerConfig, out *gcp.WorkerConfig, s conversion.Scope) error {
 	out.GPU = (*gcp.GPU)(unsafe.Pointer(in.GPU))
 	out.Volume = (*gcp.Volume)(unsafe.Pointer(in.Volume))
@@ -996,6 +1684,9 @@ func autoConvert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in *WorkerConfig, out
 	out.MinCpuPlatform = (*string)(unsafe.Pointer(in.MinCpuPlatform))
 	out.ServiceAccount = (*gcp.ServiceAccount)(unsafe.Pointer(in.ServiceAccount))
 	out.NodeTemplate = (*extensionsv1alpha1.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
+	out.Scheduling = (*gcp.Scheduling)(unsafe.Pointer(in.Scheduling))
+	out.AdditionalNetworkInterfaces = *(*[]gcp.AdditionalNetworkInterface)(unsafe.Pointer(&in.AdditionalNetworkInterfaces))
+	out.NodeBootstrap = (*gcp.NodeBootstrap)(unsafe.Pointer(in.NodeBootstrap))
 	return nil
 }
 
@@ -1011,6 +1702,9 @@ func autoConvert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in *gcp.WorkerConfig,
 	out.MinCpuPlatform = (*string)(unsafe.Pointer(in.MinCpuPlatform))
 	out.ServiceAccount = (*ServiceAccount)(unsafe.Pointer(in.ServiceAccount))
 	out.NodeTemplate = (*extensionsv1alpha1.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
+	out.Scheduling = (*Scheduling)(unsafe.Pointer(in.Scheduling))
+	out.AdditionalNetworkInterfaces = *(*[]AdditionalNetworkInterface)(unsafe.Pointer(&in.AdditionalNetworkInterfaces))
+	out.NodeBootstrap = (*NodeBootstrap)(unsafe.Pointer(in.NodeBootstrap))
 	return nil
 }
 
@@ -1042,6 +1736,7 @@ func Convert_gcp_WorkerStatus_To_v1alpha1_WorkerStatus(in *gcp.WorkerStatus, out
 func autoConvert_v1alpha1_WorkloadIdentityConfig_To_gcp_WorkloadIdentityConfig(in *WorkloadIdentityConfig, out *gcp.WorkloadIdentityConfig, s conversion.Scope) error {
 	out.ProjectID = in.ProjectID
 	out.CredentialsConfig = (*runtime.RawExtension)(unsafe.Pointer(in.CredentialsConfig))
+	out.QuotaProject = in.QuotaProject
 	return nil
 }
 
@@ -1053,6 +1748,7 @@ func Convert_v1alpha1_WorkloadIdentityConfig_To_gcp_WorkloadIdentityConfig(in *W
 func autoConvert_gcp_WorkloadIdentityConfig_To_v1alpha1_WorkloadIdentityConfig(in *gcp.WorkloadIdentityConfig, out *WorkloadIdentityConfig, s conversion.Scope) error {
 	out.ProjectID = in.ProjectID
 	out.CredentialsConfig = (*runtime.RawExtension)(unsafe.Pointer(in.CredentialsConfig))
+	out.QuotaProject = in.QuotaProject
 	return nil
 }
 
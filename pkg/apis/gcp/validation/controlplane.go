@@ -5,12 +5,59 @@
 package validation
 
 import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	featurevalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+var (
+	validVolumeSnapshotTypes            = sets.New(gcp.VolumeSnapshotTypeSnapshots, gcp.VolumeSnapshotTypeImages)
+	validVolumeSnapshotDeletionPolicies = sets.New(gcp.VolumeSnapshotDeletionPolicyDelete, gcp.VolumeSnapshotDeletionPolicyRetain)
+	validReplicationTypes               = sets.New(gcp.ReplicationTypeNone, gcp.ReplicationTypeRegionalPD)
+	validLoadBalancerTypes              = sets.New(gcp.LoadBalancerTypeExternal, gcp.LoadBalancerTypeInternal)
+	validNetworkTiers                   = sets.New(gcp.NetworkTierPremium, gcp.NetworkTierStandard)
+	validVolumeBindingModes             = sets.New(string(storagev1.VolumeBindingWaitForFirstConsumer), string(storagev1.VolumeBindingImmediate))
+	validStoragePoolTypes               = sets.New(gcp.StoragePoolTypeHyperdiskBalanced, gcp.StoragePoolTypeHyperdiskThroughput)
+	validNodeIPAMModes                  = sets.New(gcp.NodeIPAMModeRoutes, gcp.NodeIPAMModeAliasIP)
+
+	// allowedCCMFlags are the additional flags which can be passed to the cloud-controller-manager together with a
+	// function validating their value. Flags which are managed by the extension must not be part of this list.
+	allowedCCMFlags = map[string]func(string) error{
+		"concurrent-node-syncs":       validatePositiveInt,
+		"controller-start-interval":   validateDuration,
+		"kube-api-burst":              validatePositiveInt,
+		"kube-api-qps":                validatePositiveFloat,
+		"min-resync-period":           validateDuration,
+		"node-monitor-period":         validateDuration,
+		"node-sync-period":            validateDuration,
+		"route-reconciliation-period": validateDuration,
+	}
+
+	// see https://cloud.google.com/compute/docs/labeling-resources#requirements
+	gcpLabelKeyRegex   = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+	gcpLabelValueRegex = regexp.MustCompile(`^[a-z0-9_-]{0,63}$`)
+	// see https://cloud.google.com/vpc/docs/add-remove-network-tags#restrictions
+	gcpNetworkTagRegex = regexp.MustCompile(`^[a-z]([-a-z0-9]{0,61}[a-z0-9])?$`)
+	// see https://cloud.google.com/compute/docs/naming-resources#resource-name-format
+	gcpResourceNameRegex = regexp.MustCompile(`^[a-z]([-a-z0-9]{0,61}[a-z0-9])?$`)
+	// see https://cloud.google.com/kms/docs/getting-resource-ids
+	kmsKeyNameRegex = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+/keyRings/[^/]+/cryptoKeys/[^/]+$`)
 )
 
 // ValidateControlPlaneConfig validates a ControlPlaneConfig object.
@@ -29,6 +76,410 @@ func ValidateControlPlaneConfig(controlPlaneConfig *apisgcp.ControlPlaneConfig,
 
 	if controlPlaneConfig.CloudControllerManager != nil {
 		allErrs = append(allErrs, featurevalidation.ValidateFeatureGates(controlPlaneConfig.CloudControllerManager.FeatureGates, version, fldPath.Child("cloudControllerManager", "featureGates"))...)
+		allErrs = append(allErrs, validateCCMFlags(controlPlaneConfig.CloudControllerManager.Flags, fldPath.Child("cloudControllerManager", "flags"))...)
+
+		if mode := controlPlaneConfig.CloudControllerManager.NodeIPAMMode; mode != nil && !validNodeIPAMModes.Has(*mode) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("cloudControllerManager", "nodeIPAMMode"), *mode, sets.List(validNodeIPAMModes)))
+		}
+		allErrs = append(allErrs, validateReplicas(controlPlaneConfig.CloudControllerManager.Replicas, fldPath.Child("cloudControllerManager", "replicas"))...)
+		allErrs = append(allErrs, validateResources(controlPlaneConfig.CloudControllerManager.Resources, fldPath.Child("cloudControllerManager", "resources"))...)
+	}
+
+	if controlPlaneConfig.Storage != nil {
+		allErrs = append(allErrs, validateVolumeSnapshotClass(controlPlaneConfig.Storage.VolumeSnapshotClass, fldPath.Child("storage", "volumeSnapshotClass"))...)
+		allErrs = append(allErrs, validateStorageClasses(controlPlaneConfig.Storage.StorageClasses, controlPlaneConfig.Storage.StoragePools, fldPath.Child("storage", "storageClasses"))...)
+		allErrs = append(allErrs, validateStoragePools(controlPlaneConfig.Storage.StoragePools, allowedZones, workerZones, fldPath.Child("storage", "storagePools"))...)
+		allErrs = append(allErrs, validateStorageTopology(controlPlaneConfig.Storage.Topology, allowedZones, workerZones, fldPath.Child("storage", "topology"))...)
+	}
+
+	if controlPlaneConfig.CSIDriverController != nil {
+		csiPath := fldPath.Child("csiDriverController")
+		allErrs = append(allErrs, validateCSISidecar(controlPlaneConfig.CSIDriverController.Provisioner, csiPath.Child("provisioner"))...)
+		allErrs = append(allErrs, validateCSISidecar(controlPlaneConfig.CSIDriverController.Attacher, csiPath.Child("attacher"))...)
+		allErrs = append(allErrs, validateCSISidecar(controlPlaneConfig.CSIDriverController.Resizer, csiPath.Child("resizer"))...)
+		allErrs = append(allErrs, validateReplicas(controlPlaneConfig.CSIDriverController.Replicas, csiPath.Child("replicas"))...)
+		allErrs = append(allErrs, validateResources(controlPlaneConfig.CSIDriverController.Resources, csiPath.Child("resources"))...)
+	}
+
+	if controlPlaneConfig.LoadBalancer != nil {
+		allErrs = append(allErrs, validateLoadBalancer(controlPlaneConfig.LoadBalancer, fldPath.Child("loadBalancer"))...)
+	}
+
+	// Container-native load balancing needs pod IPs which are routable in the VPC, i.e. alias IP ranges of the nodes.
+	if controlPlaneConfig.IngressGCE != nil && ptr.Deref(controlPlaneConfig.IngressGCE.Enabled, false) && nodeIPAMMode(controlPlaneConfig) != gcp.NodeIPAMModeAliasIP {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ingressGCE", "enabled"), fmt.Sprintf("requires cloudControllerManager.nodeIPAMMode to be %s", gcp.NodeIPAMModeAliasIP)))
+	}
+
+	if controlPlaneConfig.KMS != nil && !kmsKeyNameRegex.MatchString(controlPlaneConfig.KMS.KeyName) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("kms", "keyName"), controlPlaneConfig.KMS.KeyName, "must have the format 'projects/<project>/locations/<location>/keyRings/<key-ring>/cryptoKeys/<key>'"))
+	}
+
+	return allErrs
+}
+
+func validateCCMFlags(flags map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for flag, value := range flags {
+		validateValue, ok := allowedCCMFlags[flag]
+		if !ok {
+			allErrs = append(allErrs, field.NotSupported(fldPath, flag, slices.Sorted(maps.Keys(allowedCCMFlags))))
+			continue
+		}
+		if err := validateValue(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(flag), value, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePositiveInt(value string) error {
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return err
+	}
+	if i <= 0 {
+		return fmt.Errorf("must be greater than 0")
+	}
+	return nil
+}
+
+func validatePositiveFloat(value string) error {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return err
+	}
+	if f <= 0 {
+		return fmt.Errorf("must be greater than 0")
+	}
+	return nil
+}
+
+func validateDuration(value string) error {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	if d <= 0 {
+		return fmt.Errorf("must be a positive duration")
+	}
+	return nil
+}
+
+func validateCSISidecar(config *apisgcp.CSISidecarConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config == nil {
+		return allErrs
+	}
+
+	if config.Timeout != nil && config.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), config.Timeout.Duration.String(), "must be a positive duration"))
+	}
+
+	if config.Workers != nil && *config.Workers <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("workers"), *config.Workers, "must be greater than 0"))
+	}
+
+	allErrs = append(allErrs, validateResources(config.Resources, fldPath.Child("resources"))...)
+
+	return allErrs
+}
+
+func validateReplicas(replicas *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if replicas != nil && *replicas <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *replicas, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateResources(resources *corev1.ResourceRequirements, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if resources == nil {
+		return allErrs
+	}
+
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		request, hasRequest := resources.Requests[name]
+		limit, hasLimit := resources.Limits[name]
+
+		if hasRequest && request.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requests").Key(string(name)), request.String(), "must not be negative"))
+		}
+		if hasLimit && limit.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("limits").Key(string(name)), limit.String(), "must not be negative"))
+		}
+		if hasRequest && hasLimit && request.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requests").Key(string(name)), request.String(), "must be less than or equal to the limit"))
+		}
+	}
+
+	for name := range resources.Requests {
+		if name != corev1.ResourceCPU && name != corev1.ResourceMemory {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("requests"), string(name), []string{string(corev1.ResourceCPU), string(corev1.ResourceMemory)}))
+		}
+	}
+	for name := range resources.Limits {
+		if name != corev1.ResourceCPU && name != corev1.ResourceMemory {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("limits"), string(name), []string{string(corev1.ResourceCPU), string(corev1.ResourceMemory)}))
+		}
+	}
+
+	return allErrs
+}
+
+func validateLoadBalancer(config *apisgcp.LoadBalancerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.Type != nil && !validLoadBalancerTypes.Has(*config.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), *config.Type, sets.List(validLoadBalancerTypes)))
+	}
+
+	if config.Subnet != nil {
+		if len(*config.Subnet) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("subnet"), "must not be empty if set"))
+		} else if !gcpResourceNameRegex.MatchString(*config.Subnet) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("subnet"), *config.Subnet, "must be the name of a subnet in the VPC of the shoot"))
+		}
+	}
+
+	if config.NetworkTier != nil {
+		if !validNetworkTiers.Has(*config.NetworkTier) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("networkTier"), *config.NetworkTier, sets.List(validNetworkTiers)))
+		} else if ptr.Deref(config.Type, "") == gcp.LoadBalancerTypeInternal {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("networkTier"), "is only supported for external load balancers"))
+		}
+	}
+
+	for i, sourceRange := range config.SourceRanges {
+		cidr := cidrvalidation.NewCIDR(sourceRange, fldPath.Child("sourceRanges").Index(i))
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(cidr)...)
+	}
+
+	tags := sets.New[string]()
+	for i, tag := range config.FirewallTargetTags {
+		idxPath := fldPath.Child("firewallTargetTags").Index(i)
+
+		if tags.Has(tag) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, tag))
+			continue
+		}
+		tags.Insert(tag)
+
+		if !gcpNetworkTagRegex.MatchString(tag) {
+			allErrs = append(allErrs, field.Invalid(idxPath, tag, "must start with a lowercase letter, end with a lowercase letter or digit and only contain lowercase letters, digits and '-' (at most 63 characters)"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateVolumeSnapshotClass(config *apisgcp.VolumeSnapshotClass, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config == nil {
+		return allErrs
+	}
+
+	if config.SnapshotType != nil && !validVolumeSnapshotTypes.Has(*config.SnapshotType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("snapshotType"), *config.SnapshotType, sets.List(validVolumeSnapshotTypes)))
+	}
+
+	if config.StorageLocation != nil && len(*config.StorageLocation) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("storageLocation"), "must not be empty if set"))
+	}
+
+	if config.DeletionPolicy != nil && !validVolumeSnapshotDeletionPolicies.Has(*config.DeletionPolicy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("deletionPolicy"), *config.DeletionPolicy, sets.List(validVolumeSnapshotDeletionPolicies)))
+	}
+
+	allErrs = append(allErrs, validateGCPLabels(config.Labels, fldPath.Child("labels"))...)
+
+	return allErrs
+}
+
+func validateStorageClasses(storageClasses []apisgcp.StorageClass, storagePools []apisgcp.StoragePool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		names          = sets.New[string]()
+		poolTypes      = make(map[string]string, len(storagePools))
+		defaultClasses int
+	)
+
+	for _, pool := range storagePools {
+		if _, ok := poolTypes[pool.Name]; !ok {
+			poolTypes[pool.Name] = pool.Type
+		}
+	}
+
+	for i, sc := range storageClasses {
+		idxPath := fldPath.Index(i)
+
+		if len(sc.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(sc.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), sc.Name))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(sc.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), sc.Name, msg))
+			}
+			names.Insert(sc.Name)
+		}
+
+		if len(sc.Type) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "must provide a disk type"))
+		}
+
+		if sc.ReplicationType != nil && !validReplicationTypes.Has(*sc.ReplicationType) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("replicationType"), *sc.ReplicationType, sets.List(validReplicationTypes)))
+		}
+
+		if sc.ProvisionedIops != nil && !slices.Contains(worker.AllowedTypesIops, sc.Type) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("provisionedIops"), fmt.Sprintf("is only allowed for types: %v", worker.AllowedTypesIops)))
+		}
+
+		if sc.ProvisionedThroughput != nil && !slices.Contains(worker.AllowedTypesThroughput, sc.Type) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("provisionedThroughput"), fmt.Sprintf("is only allowed for types: %v", worker.AllowedTypesThroughput)))
+		}
+
+		if ptr.Deref(sc.Default, false) {
+			defaultClasses++
+			if defaultClasses > 1 {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("default"), "at most one StorageClass can be marked as default"))
+			}
+		}
+
+		for j, poolName := range sc.StoragePools {
+			poolPath := idxPath.Child("storagePools").Index(j)
+
+			poolType, ok := poolTypes[poolName]
+			if !ok {
+				allErrs = append(allErrs, field.NotFound(poolPath, poolName))
+			} else if poolType != sc.Type {
+				allErrs = append(allErrs, field.Invalid(poolPath, poolName, fmt.Sprintf("type %q of storage pool does not match type %q of StorageClass", poolType, sc.Type)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateStoragePools(storagePools []apisgcp.StoragePool, allowedZones, workerZones sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, pool := range storagePools {
+		idxPath := fldPath.Index(i)
+
+		if len(pool.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(pool.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), pool.Name))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSLabel(pool.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), pool.Name, msg))
+			}
+			names.Insert(pool.Name)
+		}
+
+		if len(pool.Zone) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("zone"), "must provide the name of a zone in this region"))
+		} else if ok, validZones := validateZoneConstraints(allowedZones, pool.Zone); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("zone"), pool.Zone, validZones))
+		} else if !workerZones.Has(pool.Zone) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("zone"), pool.Zone, "must be part of at least one worker zone"))
+		}
+
+		if !validStoragePoolTypes.Has(pool.Type) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), pool.Type, sets.List(validStoragePoolTypes)))
+		}
+
+		if pool.ProvisionedCapacity <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("provisionedCapacity"), pool.ProvisionedCapacity, "must be positive"))
+		}
+
+		switch {
+		case pool.Type == gcp.StoragePoolTypeHyperdiskBalanced && pool.ProvisionedIops == nil:
+			allErrs = append(allErrs, field.Required(idxPath.Child("provisionedIops"), fmt.Sprintf("must provide the IOPS for %q storage pools", pool.Type)))
+		case pool.Type != gcp.StoragePoolTypeHyperdiskBalanced && pool.ProvisionedIops != nil:
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("provisionedIops"), fmt.Sprintf("is only allowed for %q storage pools", gcp.StoragePoolTypeHyperdiskBalanced)))
+		case pool.ProvisionedIops != nil && *pool.ProvisionedIops <= 0:
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("provisionedIops"), *pool.ProvisionedIops, "must be positive"))
+		}
+
+		if pool.ProvisionedThroughput <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("provisionedThroughput"), pool.ProvisionedThroughput, "must be positive"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateStoragePoolNames validates that the names of the storage pools in GCP, which are prefixed with the
+// technical ID of the shoot, do not exceed the maximum length of 63 characters.
+func ValidateStoragePoolNames(controlPlaneConfig *apisgcp.ControlPlaneConfig, technicalID string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if controlPlaneConfig == nil || controlPlaneConfig.Storage == nil {
+		return allErrs
+	}
+
+	for i, pool := range controlPlaneConfig.Storage.StoragePools {
+		if name := technicalID + "-" + pool.Name; len(name) > 63 {
+			allErrs = append(allErrs, field.TooLong(fldPath.Index(i).Child("name"), pool.Name, 63-len(technicalID)-1))
+		}
+	}
+
+	return allErrs
+}
+
+func validateStorageTopology(topology *apisgcp.StorageTopology, allowedZones, workerZones sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if topology == nil {
+		return allErrs
+	}
+
+	zones := sets.New[string]()
+	for i, zone := range topology.AllowedZones {
+		idxPath := fldPath.Child("allowedZones").Index(i)
+
+		if zones.Has(zone) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, zone))
+			continue
+		}
+		zones.Insert(zone)
+
+		if ok, validZones := validateZoneConstraints(allowedZones, zone); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath, zone, validZones))
+		} else if !workerZones.Has(zone) {
+			allErrs = append(allErrs, field.Invalid(idxPath, zone, "must be part of at least one worker zone"))
+		}
+	}
+
+	if topology.VolumeBindingMode != nil && !validVolumeBindingModes.Has(*topology.VolumeBindingMode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("volumeBindingMode"), *topology.VolumeBindingMode, sets.List(validVolumeBindingModes)))
+	}
+
+	return allErrs
+}
+
+func validateGCPLabels(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for k, v := range labels {
+		if !gcpLabelKeyRegex.MatchString(k) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(k), k, "must start with a lowercase letter and only contain lowercase letters, digits, '_' and '-' (at most 63 characters)"))
+		}
+		if !gcpLabelValueRegex.MatchString(v) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(k), v, "must only contain lowercase letters, digits, '_' and '-' (at most 63 characters)"))
+		}
 	}
 
 	return allErrs
@@ -39,10 +490,44 @@ func ValidateControlPlaneConfigUpdate(oldConfig, newConfig *apisgcp.ControlPlane
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Zone, oldConfig.Zone, fldPath.Child("zone"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(nodeIPAMMode(newConfig), nodeIPAMMode(oldConfig), fldPath.Child("cloudControllerManager", "nodeIPAMMode"))...)
+
+	if oldConfig.KMS != nil {
+		if newConfig.KMS == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("kms"), "cannot be removed once set"))
+		} else {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.KMS.KeyName, oldConfig.KMS.KeyName, fldPath.Child("kms", "keyName"))...)
+		}
+	}
+
+	if oldConfig.Storage != nil && newConfig.Storage != nil {
+		oldPools := make(map[string]apisgcp.StoragePool, len(oldConfig.Storage.StoragePools))
+		for _, pool := range oldConfig.Storage.StoragePools {
+			oldPools[pool.Name] = pool
+		}
+
+		for i, pool := range newConfig.Storage.StoragePools {
+			oldPool, ok := oldPools[pool.Name]
+			if !ok {
+				continue
+			}
+
+			idxPath := fldPath.Child("storage", "storagePools").Index(i)
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(pool.Zone, oldPool.Zone, idxPath.Child("zone"))...)
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(pool.Type, oldPool.Type, idxPath.Child("type"))...)
+		}
+	}
 
 	return allErrs
 }
 
+func nodeIPAMMode(config *apisgcp.ControlPlaneConfig) string {
+	if config.CloudControllerManager == nil {
+		return ""
+	}
+	return ptr.Deref(config.CloudControllerManager.NodeIPAMMode, "")
+}
+
 func validateZoneConstraints(allowedZones sets.Set[string], zone string) (bool, []string) {
 	if allowedZones.Has(zone) {
 		return true, nil
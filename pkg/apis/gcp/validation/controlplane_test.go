@@ -5,11 +5,17 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
@@ -94,6 +100,532 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 				})),
 			))
 		})
+
+		It("should allow supported CCM flags", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				Flags: map[string]string{
+					"concurrent-node-syncs": "5",
+					"kube-api-qps":          "50.5",
+					"node-monitor-period":   "10s",
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(BeEmpty())
+		})
+
+		It("should fail with unsupported or invalid CCM flags", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				Flags: map[string]string{
+					"cluster-name":          "foo",
+					"concurrent-node-syncs": "0",
+					"kube-api-qps":          "fast",
+					"node-monitor-period":   "10",
+				},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeNotSupported),
+					"Field":    Equal("cloudControllerManager.flags"),
+					"BadValue": Equal("cluster-name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.flags[concurrent-node-syncs]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.flags[kube-api-qps]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.flags[node-monitor-period]"),
+				})),
+			))
+		})
+
+		It("should allow valid replicas and resources", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				Replicas: ptr.To[int32](2),
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+			}
+			controlPlane.CSIDriverController = &apisgcp.CSIDriverControllerConfig{
+				Replicas: ptr.To[int32](2),
+				Provisioner: &apisgcp.CSISidecarConfig{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+					},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(BeEmpty())
+		})
+
+		It("should fail with invalid replicas and resources", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				Replicas: ptr.To[int32](0),
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), "nvidia.com/gpu": resource.MustParse("1")},
+					Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				},
+			}
+			controlPlane.CSIDriverController = &apisgcp.CSIDriverControllerConfig{
+				Attacher: &apisgcp.CSISidecarConfig{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Mi")},
+					},
+				},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.replicas"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.resources.requests[cpu]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("cloudControllerManager.resources.requests"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("csiDriverController.attacher.resources.requests[memory]"),
+				})),
+			))
+		})
+
+		It("should allow a valid KMS key name", func() {
+			controlPlane.KMS = &apisgcp.KMSConfig{KeyName: "projects/foo/locations/europe/keyRings/bar/cryptoKeys/baz"}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(BeEmpty())
+		})
+
+		It("should fail with an invalid KMS key name", func() {
+			controlPlane.KMS = &apisgcp.KMSConfig{KeyName: "projects/foo/keyRings/bar"}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("kms.keyName"),
+			}))))
+		})
+
+		It("should allow a supported node IPAM mode", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				NodeIPAMMode: ptr.To("AliasIP"),
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(BeEmpty())
+		})
+
+		It("should fail with an unsupported node IPAM mode", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				NodeIPAMMode: ptr.To("Overlay"),
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("cloudControllerManager.nodeIPAMMode"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig storage", func() {
+		It("should allow a valid volume snapshot class configuration", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				VolumeSnapshotClass: &apisgcp.VolumeSnapshotClass{
+					SnapshotType:    ptr.To("snapshots"),
+					StorageLocation: ptr.To("us"),
+					Labels:          map[string]string{"team": "foo-bar_1"},
+					DeletionPolicy:  ptr.To("Delete"),
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid volume snapshot class configuration", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				VolumeSnapshotClass: &apisgcp.VolumeSnapshotClass{
+					SnapshotType:    ptr.To("foo"),
+					StorageLocation: ptr.To(""),
+					Labels:          map[string]string{"Team": "bar"},
+					DeletionPolicy:  ptr.To("Keep"),
+				},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.volumeSnapshotClass.snapshotType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("storage.volumeSnapshotClass.storageLocation"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.volumeSnapshotClass.labels[Team]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.volumeSnapshotClass.deletionPolicy"),
+				})),
+			))
+		})
+
+		It("should allow valid storage classes", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				StorageClasses: []apisgcp.StorageClass{
+					{Name: "default", Type: "hyperdisk-balanced", Default: ptr.To(true), ProvisionedIops: ptr.To[int64](3000), ProvisionedThroughput: ptr.To[int64](140)},
+					{Name: "regional", Type: "pd-balanced", ReplicationType: ptr.To("regional-pd")},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid storage classes", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				StorageClasses: []apisgcp.StorageClass{
+					{Name: "default", Type: "pd-ssd", Default: ptr.To(true), ProvisionedIops: ptr.To[int64](3000)},
+					{Name: "default", Type: "pd-balanced", Default: ptr.To(true), ReplicationType: ptr.To("multi-region")},
+					{Type: "pd-standard", ProvisionedThroughput: ptr.To[int64](140)},
+					{Name: "foo"},
+				},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[0].provisionedIops"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("storage.storageClasses[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.storageClasses[1].replicationType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[1].default"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("storage.storageClasses[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[2].provisionedThroughput"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("storage.storageClasses[3].type"),
+				})),
+			))
+		})
+
+		It("should allow a valid topology configuration", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				Topology: &apisgcp.StorageTopology{
+					AllowedZones:      []string{zone},
+					VolumeBindingMode: ptr.To("Immediate"),
+					StrictTopology:    ptr.To(true),
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid topology configuration", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				Topology: &apisgcp.StorageTopology{
+					AllowedZones:      []string{zone, zone, "foo"},
+					VolumeBindingMode: ptr.To("Later"),
+				},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("storage.topology.allowedZones[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.topology.allowedZones[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.topology.volumeBindingMode"),
+				})),
+			))
+		})
+		It("should allow valid storage pools", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				StorageClasses: []apisgcp.StorageClass{
+					{Name: "pooled", Type: "hyperdisk-balanced", StoragePools: []string{"pool-a"}},
+				},
+				StoragePools: []apisgcp.StoragePool{
+					{Name: "pool-a", Zone: zone, Type: "hyperdisk-balanced", ProvisionedCapacity: 10240, ProvisionedIops: ptr.To[int64](10000), ProvisionedThroughput: 1024},
+					{Name: "pool-b", Zone: "zone1", Type: "hyperdisk-throughput", ProvisionedCapacity: 10240, ProvisionedThroughput: 1024},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid storage pools", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				StorageClasses: []apisgcp.StorageClass{
+					{Name: "pooled", Type: "hyperdisk-balanced", StoragePools: []string{"pool-a", "pool-b", "pool-c"}},
+				},
+				StoragePools: []apisgcp.StoragePool{
+					{Name: "pool-a", Zone: zone, Type: "hyperdisk-balanced", ProvisionedCapacity: 10240, ProvisionedThroughput: 1024},
+					{Name: "pool-b", Zone: "foo", Type: "hyperdisk-throughput", ProvisionedCapacity: 0, ProvisionedIops: ptr.To[int64](10000), ProvisionedThroughput: 1024},
+					{Name: "pool-a", Zone: zone, Type: "pd-ssd", ProvisionedCapacity: 10240, ProvisionedThroughput: 0},
+				},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.storageClasses[0].storagePools[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("storage.storageClasses[0].storagePools[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("storage.storagePools[0].provisionedIops"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.storagePools[1].zone"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.storagePools[1].provisionedCapacity"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storagePools[1].provisionedIops"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("storage.storagePools[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.storagePools[2].type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.storagePools[2].provisionedThroughput"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig csiDriverController", func() {
+		It("should allow valid sidecar configurations", func() {
+			controlPlane.CSIDriverController = &apisgcp.CSIDriverControllerConfig{
+				Attacher: &apisgcp.CSISidecarConfig{Timeout: &metav1.Duration{Duration: time.Minute}, Workers: ptr.To[int32](20)},
+				Resizer:  &apisgcp.CSISidecarConfig{Workers: ptr.To[int32](20)},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid sidecar configurations", func() {
+			controlPlane.CSIDriverController = &apisgcp.CSIDriverControllerConfig{
+				Provisioner: &apisgcp.CSISidecarConfig{Timeout: &metav1.Duration{Duration: -time.Second}},
+				Resizer:     &apisgcp.CSISidecarConfig{Workers: ptr.To[int32](0)},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("csiDriverController.provisioner.timeout"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("csiDriverController.resizer.workers"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig loadBalancer", func() {
+		It("should allow a valid load balancer configuration", func() {
+			controlPlane.LoadBalancer = &apisgcp.LoadBalancerConfig{
+				Type:         ptr.To("Internal"),
+				GlobalAccess: ptr.To(true),
+				Subnet:       ptr.To("lb-subnet"),
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid load balancer configuration", func() {
+			controlPlane.LoadBalancer = &apisgcp.LoadBalancerConfig{
+				Type:   ptr.To("Public"),
+				Subnet: ptr.To(""),
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("loadBalancer.type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("loadBalancer.subnet"),
+				})),
+			))
+		})
+
+		It("should forbid a subnet which is not a subnet name", func() {
+			controlPlane.LoadBalancer = &apisgcp.LoadBalancerConfig{
+				Subnet: ptr.To("projects/foo/regions/europe-west1/subnetworks/lb-subnet"),
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancer.subnet"),
+				})),
+			))
+		})
+
+		It("should allow valid source ranges, network tier and firewall target tags", func() {
+			controlPlane.LoadBalancer = &apisgcp.LoadBalancerConfig{
+				NetworkTier:        ptr.To("Standard"),
+				SourceRanges:       []string{"10.0.0.0/8", "2001:db8::/32"},
+				FirewallTargetTags: []string{"lb-backends"},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid source ranges, network tier and firewall target tags", func() {
+			controlPlane.LoadBalancer = &apisgcp.LoadBalancerConfig{
+				NetworkTier:        ptr.To("Gold"),
+				SourceRanges:       []string{"10.0.0.0/8", "foo"},
+				FirewallTargetTags: []string{"lb-backends", "lb-backends", "Invalid_Tag"},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("loadBalancer.networkTier"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancer.sourceRanges[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("loadBalancer.firewallTargetTags[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancer.firewallTargetTags[2]"),
+				})),
+			))
+		})
+
+		It("should forbid a network tier for internal load balancers", func() {
+			controlPlane.LoadBalancer = &apisgcp.LoadBalancerConfig{
+				Type:        ptr.To("Internal"),
+				NetworkTier: ptr.To("Standard"),
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("loadBalancer.networkTier"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateStoragePoolNames", func() {
+		BeforeEach(func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				StoragePools: []apisgcp.StoragePool{
+					{Name: "pool-a"},
+					{Name: "pool-with-a-rather-long-name"},
+				},
+			}
+		})
+
+		It("should allow storage pool names within the limit", func() {
+			Expect(ValidateStoragePoolNames(controlPlane, "shoot--foo--bar", fldPath.Child("storage", "storagePools"))).To(BeEmpty())
+		})
+
+		It("should forbid storage pool names exceeding the limit together with the technical ID", func() {
+			errorList := ValidateStoragePoolNames(controlPlane, "shoot--a-long-project--a-shoot-with-a-long-name", fldPath.Child("storage", "storagePools"))
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeTooLong),
+				"Field": Equal("storage.storagePools[1].name"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig ingressGCE", func() {
+		It("should allow enabling ingress-gce with the alias IP mode", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{NodeIPAMMode: ptr.To("AliasIP")}
+			controlPlane.IngressGCE = &apisgcp.IngressGCEConfig{Enabled: ptr.To(true)}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should allow disabling ingress-gce without the alias IP mode", func() {
+			controlPlane.IngressGCE = &apisgcp.IngressGCEConfig{Enabled: ptr.To(false)}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid enabling ingress-gce without the alias IP mode", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{NodeIPAMMode: ptr.To("Routes")}
+			controlPlane.IngressGCE = &apisgcp.IngressGCEConfig{Enabled: ptr.To(true)}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("ingressGCE.enabled"),
+			}))))
+		})
 	})
 
 	Describe("#ValidateControlPlaneConfigUpdate", func() {
@@ -112,5 +644,61 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 				"Field": Equal("zone"),
 			}))))
 		})
+
+		It("should forbid changing or removing the KMS key", func() {
+			controlPlane.KMS = &apisgcp.KMSConfig{KeyName: "projects/foo/locations/europe/keyRings/bar/cryptoKeys/baz"}
+			newControlPlane := controlPlane.DeepCopy()
+			newControlPlane.KMS.KeyName = "projects/foo/locations/europe/keyRings/bar/cryptoKeys/other"
+
+			Expect(ValidateControlPlaneConfigUpdate(controlPlane, newControlPlane, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("kms.keyName"),
+			}))))
+
+			newControlPlane.KMS = nil
+			Expect(ValidateControlPlaneConfigUpdate(controlPlane, newControlPlane, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("kms"),
+			}))))
+		})
+
+		It("should forbid changing the node IPAM mode", func() {
+			newControlPlane := controlPlane.DeepCopy()
+			newControlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				NodeIPAMMode: ptr.To("AliasIP"),
+			}
+
+			errorList := ValidateControlPlaneConfigUpdate(controlPlane, newControlPlane, fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("cloudControllerManager.nodeIPAMMode"),
+			}))))
+		})
+
+		It("should forbid changing the zone or type of a storage pool", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				StoragePools: []apisgcp.StoragePool{
+					{Name: "pool-a", Zone: zone, Type: "hyperdisk-balanced", ProvisionedCapacity: 10240, ProvisionedIops: ptr.To[int64](10000), ProvisionedThroughput: 1024},
+				},
+			}
+			newControlPlane := controlPlane.DeepCopy()
+			newControlPlane.Storage.StoragePools[0].Zone = "zone1"
+			newControlPlane.Storage.StoragePools[0].Type = "hyperdisk-throughput"
+			newControlPlane.Storage.StoragePools[0].ProvisionedCapacity = 20480
+
+			errorList := ValidateControlPlaneConfigUpdate(controlPlane, newControlPlane, fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.storagePools[0].zone"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.storagePools[0].type"),
+				})),
+			))
+		})
 	})
 })
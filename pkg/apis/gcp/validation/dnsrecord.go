@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+var (
+	supportedDNSRoutingPolicyTypes      = []string{string(apisgcp.DNSRoutingPolicyWeighted), string(apisgcp.DNSRoutingPolicyGeolocation)}
+	supportedDNSManagedZoneVisibilities = []string{string(apisgcp.DNSManagedZoneVisibilityPublic), string(apisgcp.DNSManagedZoneVisibilityPrivate)}
+)
+
+// ValidateDNSRecordConfig validates a DNSRecordConfig object.
+func ValidateDNSRecordConfig(config *apisgcp.DNSRecordConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config == nil {
+		return allErrs
+	}
+
+	if config.ProjectID != nil && len(*config.ProjectID) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("projectID"), *config.ProjectID, "must not be empty"))
+	}
+
+	if config.Target != nil {
+		targetPath := fldPath.Child("target")
+		if len(config.Target.ForwardingRule) == 0 {
+			allErrs = append(allErrs, field.Required(targetPath.Child("forwardingRule"), "must be set"))
+		}
+		if config.Target.Region != nil && len(*config.Target.Region) == 0 {
+			allErrs = append(allErrs, field.Invalid(targetPath.Child("region"), *config.Target.Region, "must not be empty"))
+		}
+	}
+
+	if config.ManagedZone != nil {
+		allErrs = append(allErrs, validateDNSManagedZone(config.ManagedZone, fldPath.Child("managedZone"))...)
+	}
+
+	if config.RoutingPolicy == nil {
+		return allErrs
+	}
+
+	policy := config.RoutingPolicy
+	policyPath := fldPath.Child("routingPolicy")
+
+	switch policy.Type {
+	case apisgcp.DNSRoutingPolicyWeighted:
+		if policy.Index == nil {
+			allErrs = append(allErrs, field.Required(policyPath.Child("index"), "must be set for weighted routing policies"))
+		} else if *policy.Index < 0 {
+			allErrs = append(allErrs, field.Invalid(policyPath.Child("index"), *policy.Index, "must not be negative"))
+		}
+		if policy.Weight == nil {
+			allErrs = append(allErrs, field.Required(policyPath.Child("weight"), "must be set for weighted routing policies"))
+		} else if *policy.Weight < 1 {
+			allErrs = append(allErrs, field.Invalid(policyPath.Child("weight"), *policy.Weight, "must be at least 1"))
+		}
+		if policy.Location != nil {
+			allErrs = append(allErrs, field.Forbidden(policyPath.Child("location"), "must not be set for weighted routing policies"))
+		}
+	case apisgcp.DNSRoutingPolicyGeolocation:
+		if policy.Location == nil || len(*policy.Location) == 0 {
+			allErrs = append(allErrs, field.Required(policyPath.Child("location"), "must be set for geolocation routing policies"))
+		}
+		if policy.Index != nil {
+			allErrs = append(allErrs, field.Forbidden(policyPath.Child("index"), "must not be set for geolocation routing policies"))
+		}
+		if policy.Weight != nil {
+			allErrs = append(allErrs, field.Forbidden(policyPath.Child("weight"), "must not be set for geolocation routing policies"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(policyPath.Child("type"), policy.Type, supportedDNSRoutingPolicyTypes))
+	}
+
+	return allErrs
+}
+
+func validateDNSManagedZone(managedZone *apisgcp.DNSManagedZone, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(managedZone.DNSName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("dnsName"), "must be set"))
+	}
+
+	visibility := apisgcp.DNSManagedZoneVisibilityPublic
+	if managedZone.Visibility != nil {
+		visibility = *managedZone.Visibility
+	}
+
+	switch visibility {
+	case apisgcp.DNSManagedZoneVisibilityPublic:
+		if len(managedZone.Networks) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("networks"), "must not be set for public managed zones"))
+		}
+	case apisgcp.DNSManagedZoneVisibilityPrivate:
+		if len(managedZone.Networks) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("networks"), "must be set for private managed zones"))
+		}
+		for i, network := range managedZone.Networks {
+			if len(network) == 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("networks").Index(i), network, "must not be empty"))
+			}
+		}
+		if managedZone.DNSSEC != nil && *managedZone.DNSSEC {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("dnssec"), "is not supported for private managed zones"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("visibility"), visibility, supportedDNSManagedZoneVisibilities))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+)
+
+var _ = Describe("#ValidateDNSRecordConfig", func() {
+	var fldPath *field.Path
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("spec", "providerConfig")
+	})
+
+	It("should allow a config without routing policy", func() {
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{}, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow a config with project ID", func() {
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{ProjectID: ptr.To("dns-project")}, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid an empty project ID", func() {
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{ProjectID: ptr.To("")}, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.projectID"),
+			})),
+		))
+	})
+
+	It("should allow a config with target", func() {
+		config := &apisgcp.DNSRecordConfig{
+			Target: &apisgcp.DNSRecordTarget{ForwardingRule: "lb", Region: ptr.To("europe-west1")},
+		}
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid an invalid target", func() {
+		config := &apisgcp.DNSRecordConfig{
+			Target: &apisgcp.DNSRecordTarget{Region: ptr.To("")},
+		}
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.target.forwardingRule"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.target.region"),
+			})),
+		))
+	})
+
+	It("should allow valid managed zones", func() {
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{
+			ManagedZone: &apisgcp.DNSManagedZone{DNSName: "example.com", DNSSEC: ptr.To(true)},
+		}, fldPath)).To(BeEmpty())
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{
+			ManagedZone: &apisgcp.DNSManagedZone{
+				DNSName:    "example.com",
+				Visibility: ptr.To(apisgcp.DNSManagedZoneVisibilityPrivate),
+				Networks:   []string{"my-network"},
+			},
+		}, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid invalid public managed zones", func() {
+		config := &apisgcp.DNSRecordConfig{
+			ManagedZone: &apisgcp.DNSManagedZone{Networks: []string{"my-network"}},
+		}
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.managedZone.dnsName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.providerConfig.managedZone.networks"),
+			})),
+		))
+	})
+
+	It("should forbid invalid private managed zones", func() {
+		config := &apisgcp.DNSRecordConfig{
+			ManagedZone: &apisgcp.DNSManagedZone{
+				DNSName:    "example.com",
+				Visibility: ptr.To(apisgcp.DNSManagedZoneVisibilityPrivate),
+				DNSSEC:     ptr.To(true),
+			},
+		}
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.managedZone.networks"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.providerConfig.managedZone.dnssec"),
+			})),
+		))
+	})
+
+	It("should forbid an unsupported managed zone visibility", func() {
+		config := &apisgcp.DNSRecordConfig{
+			ManagedZone: &apisgcp.DNSManagedZone{
+				DNSName:    "example.com",
+				Visibility: ptr.To(apisgcp.DNSManagedZoneVisibility("internal")),
+			},
+		}
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.providerConfig.managedZone.visibility"),
+			})),
+		))
+	})
+
+	It("should allow a valid weighted routing policy", func() {
+		config := &apisgcp.DNSRecordConfig{
+			RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+				Type:   apisgcp.DNSRoutingPolicyWeighted,
+				Index:  ptr.To[int32](1),
+				Weight: ptr.To[int64](10),
+			},
+		}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow a valid geolocation routing policy", func() {
+		config := &apisgcp.DNSRecordConfig{
+			RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+				Type:     apisgcp.DNSRoutingPolicyGeolocation,
+				Location: ptr.To("europe-west1"),
+			},
+		}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid an unsupported routing policy type", func() {
+		config := &apisgcp.DNSRecordConfig{
+			RoutingPolicy: &apisgcp.DNSRoutingPolicy{Type: "failover"},
+		}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.providerConfig.routingPolicy.type"),
+			})),
+		))
+	})
+
+	It("should forbid invalid weighted routing policies", func() {
+		config := &apisgcp.DNSRecordConfig{
+			RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+				Type:     apisgcp.DNSRoutingPolicyWeighted,
+				Weight:   ptr.To[int64](0),
+				Location: ptr.To("europe-west1"),
+			},
+		}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.routingPolicy.index"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.routingPolicy.weight"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.providerConfig.routingPolicy.location"),
+			})),
+		))
+	})
+
+	It("should forbid invalid geolocation routing policies", func() {
+		config := &apisgcp.DNSRecordConfig{
+			RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+				Type:   apisgcp.DNSRoutingPolicyGeolocation,
+				Weight: ptr.To[int64](10),
+			},
+		}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.routingPolicy.location"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.providerConfig.routingPolicy.weight"),
+			})),
+		))
+	})
+})
@@ -35,5 +35,15 @@ func ValidateCloudProviderSecret(secret *corev1.Secret) error {
 		return fmt.Errorf("service account project ID does not match the expected format '%s'", projectIDRegexp)
 	}
 
+	// The terraformer, the cloud-controller-manager, the CSI driver and the machine-controller-manager use the
+	// service account directly, hence impersonating another service account is not supported.
+	if _, ok := secret.Data[gcp.ImpersonateServiceAccountField]; ok {
+		return fmt.Errorf("%q field is not supported", gcp.ImpersonateServiceAccountField)
+	}
+
+	if sa.QuotaProject != "" && !projectIDRegexp.MatchString(sa.QuotaProject) {
+		return fmt.Errorf("service account quota project ID does not match the expected format '%s'", projectIDRegexp)
+	}
+
 	return nil
 }
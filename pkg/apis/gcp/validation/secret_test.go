@@ -51,6 +51,18 @@ var _ = Describe("Secret validation", func() {
 		Entry("should succeed when the credential type and project ID is valid",
 			map[string][]byte{gcp.ServiceAccountJSONField: []byte(`{"project_id": "my-project", "type": "service_account"}`)},
 			BeNil()),
+		Entry("should return error when a service account to impersonate is configured",
+			map[string][]byte{
+				gcp.ServiceAccountJSONField:        []byte(`{"project_id": "my-project", "type": "service_account"}`),
+				gcp.ImpersonateServiceAccountField: []byte(`shoot-operator@my-project.iam.gserviceaccount.com`),
+			},
+			HaveOccurred()),
+		Entry("should succeed when the quota project is valid",
+			map[string][]byte{gcp.ServiceAccountJSONField: []byte(`{"project_id": "my-project", "quota_project_id": "my-billing-project", "type": "service_account"}`)},
+			BeNil()),
+		Entry("should return error when the quota project is not a project ID",
+			map[string][]byte{gcp.ServiceAccountJSONField: []byte(`{"project_id": "my-project", "quota_project_id": "My Billing Project", "type": "service_account"}`)},
+			HaveOccurred()),
 		Entry("should fail when the credential type is in not in the allowed list",
 			map[string][]byte{gcp.ServiceAccountJSONField: []byte(`{"project_id": "my-project", "type": "service_account"}`)},
 			BeNil()),
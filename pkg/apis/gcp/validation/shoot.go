@@ -16,7 +16,9 @@ import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
 // ValidateNetworking validates the network settings of a Shoot.
@@ -38,6 +40,26 @@ func ValidateNetworking(networking *core.Networking, fldPath *field.Path, k8sVer
 	return allErrs
 }
 
+// ValidateNodeIPAMMode validates the node IPAM mode of the control plane configuration against the network settings
+// of a Shoot.
+func ValidateNodeIPAMMode(controlPlaneConfig *apisgcp.ControlPlaneConfig, networking *core.Networking, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if controlPlaneConfig.CloudControllerManager == nil || controlPlaneConfig.CloudControllerManager.NodeIPAMMode == nil {
+		return allErrs
+	}
+
+	mode := *controlPlaneConfig.CloudControllerManager.NodeIPAMMode
+	if mode == gcp.NodeIPAMModeRoutes && !core.IsIPv4SingleStack(networking.IPFamilies) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "route-based pod networking is not supported for dual-stack shoots"))
+	}
+	if mode == gcp.NodeIPAMModeAliasIP && networking.Pods == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "a pods CIDR must be provided for alias IP based pod networking"))
+	}
+
+	return allErrs
+}
+
 // ValidateWorkers validates the workers of a Shoot.
 func ValidateWorkers(workers []core.Worker, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
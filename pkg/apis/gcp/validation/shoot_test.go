@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
 )
 
@@ -84,6 +85,57 @@ var _ = Describe("Shoot validation", func() {
 			})
 		})
 	})
+	Describe("#ValidateNodeIPAMMode", func() {
+		fldPath := field.NewPath("spec", "provider", "controlPlaneConfig", "cloudControllerManager", "nodeIPAMMode")
+
+		var (
+			networking         *core.Networking
+			controlPlaneConfig *apisgcp.ControlPlaneConfig
+		)
+
+		BeforeEach(func() {
+			networking = &core.Networking{
+				Pods:       ptr.To("100.96.0.0/11"),
+				IPFamilies: []core.IPFamily{core.IPFamilyIPv4},
+			}
+			controlPlaneConfig = &apisgcp.ControlPlaneConfig{
+				CloudControllerManager: &apisgcp.CloudControllerManagerConfig{},
+			}
+		})
+
+		It("should pass if no node IPAM mode is configured", func() {
+			networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+
+			Expect(ValidateNodeIPAMMode(controlPlaneConfig, networking, fldPath)).To(BeEmpty())
+		})
+
+		It("should pass for the alias IP mode", func() {
+			controlPlaneConfig.CloudControllerManager.NodeIPAMMode = ptr.To("AliasIP")
+
+			Expect(ValidateNodeIPAMMode(controlPlaneConfig, networking, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid the routes mode for dual-stack shoots", func() {
+			controlPlaneConfig.CloudControllerManager.NodeIPAMMode = ptr.To("Routes")
+			networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+
+			Expect(ValidateNodeIPAMMode(controlPlaneConfig, networking, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal(fldPath.String()),
+			}))))
+		})
+
+		It("should require a pods CIDR for the alias IP mode", func() {
+			controlPlaneConfig.CloudControllerManager.NodeIPAMMode = ptr.To("AliasIP")
+			networking.Pods = nil
+
+			Expect(ValidateNodeIPAMMode(controlPlaneConfig, networking, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal(fldPath.String()),
+			}))))
+		})
+	})
+
 	Describe("#ValidateWorkers", func() {
 		It("should pass successfully", func() {
 			workers := []core.Worker{
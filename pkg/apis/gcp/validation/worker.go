@@ -6,10 +6,12 @@ package validation
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	"golang.org/x/exp/slices"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -20,8 +22,11 @@ import (
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
 )
 
+const maxAdditionalNetworkInterfaces = 7
+
 var (
 	validVolumeLocalSSDInterfacesTypes = sets.New("NVME", "SCSI")
+	validOnHostMaintenancePolicies     = sets.New(worker.OnHostMaintenanceMigrate, worker.OnHostMaintenanceTerminate)
 
 	providerFldPath   = field.NewPath("providerConfig")
 	volumeFldPath     = providerFldPath.Child("volume")
@@ -44,9 +49,12 @@ func ValidateWorkerConfig(workerConfig *gcp.WorkerConfig, dataVolumes []core.Dat
 			allErrs = append(allErrs, validateDiskEncryption(workerConfig.Volume.Encryption, volumeFldPath.Child("encryption"))...)
 		}
 		allErrs = append(allErrs, validateNodeTemplate(workerConfig.NodeTemplate, providerFldPath.Child("nodeTemplate"))...)
+		allErrs = append(allErrs, validateScheduling(workerConfig.Scheduling, workerConfig.GPU, providerFldPath.Child("scheduling"))...)
 		if workerConfig.DataVolumes != nil {
 			allErrs = append(allErrs, validateDataVolumeConfigs(dataVolumes, workerConfig.DataVolumes)...)
 		}
+		allErrs = append(allErrs, validateAdditionalNetworkInterfaces(workerConfig.AdditionalNetworkInterfaces, providerFldPath.Child("additionalNetworkInterfaces"))...)
+		allErrs = append(allErrs, validateNodeBootstrap(workerConfig.NodeBootstrap, workerConfig.Volume, providerFldPath.Child("nodeBootstrap"))...)
 	}
 
 	return allErrs
@@ -101,6 +109,85 @@ func validateServiceAccount(sa *gcp.ServiceAccount, fldPath *field.Path) field.E
 	return allErrs
 }
 
+func validateScheduling(scheduling *gcp.Scheduling, gpu *gcp.GPU, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if scheduling == nil || scheduling.OnHostMaintenance == nil {
+		return allErrs
+	}
+
+	onHostMaintenancePath := fldPath.Child("onHostMaintenance")
+	onHostMaintenance := *scheduling.OnHostMaintenance
+
+	if !validOnHostMaintenancePolicies.Has(onHostMaintenance) {
+		allErrs = append(allErrs, field.NotSupported(onHostMaintenancePath, onHostMaintenance, sets.List(validOnHostMaintenancePolicies)))
+	} else if onHostMaintenance == worker.OnHostMaintenanceMigrate && gpu != nil {
+		// VMs with attached GPUs can't be live migrated, see https://cloud.google.com/compute/docs/gpus/gpu-host-maintenance
+		allErrs = append(allErrs, field.Forbidden(onHostMaintenancePath, fmt.Sprintf("must not be %s when providing gpu", worker.OnHostMaintenanceMigrate)))
+	}
+
+	return allErrs
+}
+
+func validateAdditionalNetworkInterfaces(nics []gcp.AdditionalNetworkInterface, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// GCP supports at most 8 network interfaces per VM, one of them is always attached to the nodes subnet.
+	if len(nics) > maxAdditionalNetworkInterfaces {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(nics), maxAdditionalNetworkInterfaces))
+	}
+
+	networks := sets.New[string]()
+	for i, nic := range nics {
+		idxPath := fldPath.Index(i)
+
+		if nic.Network == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("network"), "must be set when providing an additional network interface"))
+		} else if networks.Has(nic.Network) {
+			// Each network interface of a VM must be attached to a different VPC network.
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("network"), nic.Network))
+		} else {
+			networks.Insert(nic.Network)
+		}
+
+		if nic.Subnetwork == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("subnetwork"), "must be set when providing an additional network interface"))
+		}
+
+		for j, route := range nic.Routes {
+			cidr := cidrvalidation.NewCIDR(route, idxPath.Child("routes").Index(j))
+			allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(cidr)...)
+		}
+	}
+
+	return allErrs
+}
+
+const localSSDMountPathPrefix = "/mnt/"
+
+func validateNodeBootstrap(bootstrap *gcp.NodeBootstrap, volume *gcp.Volume, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if bootstrap == nil || bootstrap.LocalSSD == nil {
+		return allErrs
+	}
+
+	localSSDPath := fldPath.Child("localSSD")
+	if volume == nil || volume.LocalSSDInterface == nil || *volume.LocalSSDInterface != worker.LocalSSDInterfaceNVME {
+		allErrs = append(allErrs, field.Forbidden(localSSDPath, fmt.Sprintf("is only allowed for %s volumes with interface %s", worker.VolumeTypeScratch, worker.LocalSSDInterfaceNVME)))
+	}
+
+	mountPath := bootstrap.LocalSSD.MountPath
+	if mountPath == "" {
+		allErrs = append(allErrs, field.Required(localSSDPath.Child("mountPath"), "must be set when formatting local SSDs"))
+	} else if path.Clean(mountPath) != mountPath || !strings.HasPrefix(mountPath, localSSDMountPathPrefix) {
+		// Mounting over system directories like /var or /var/lib/kubelet would render the node unusable.
+		allErrs = append(allErrs, field.Invalid(localSSDPath.Child("mountPath"), mountPath, fmt.Sprintf("must be a clean path below %s", localSSDMountPathPrefix)))
+	}
+
+	return allErrs
+}
+
 // validateDiskEncryption validates the provider specific disk encryption configuration for a volume
 func validateDiskEncryption(encryption *gcp.DiskEncryption, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
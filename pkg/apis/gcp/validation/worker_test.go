@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -339,6 +340,188 @@ var _ = Describe("#ValidateWorkers", func() {
 		Expect(errorList).To(BeEmpty())
 	})
 
+	It("should allow valid scheduling configurations", func() {
+		errorList := ValidateWorkerConfig(
+			&gcp.WorkerConfig{
+				GPU: &gcp.GPU{
+					AcceleratorType: "foo",
+					Count:           1},
+				Scheduling: &gcp.Scheduling{
+					OnHostMaintenance: ptr.To("TERMINATE"),
+					AutomaticRestart:  ptr.To(false),
+				},
+			},
+			nil,
+		)
+
+		Expect(errorList).To(BeEmpty())
+	})
+
+	It("should forbid unsupported onHostMaintenance policies", func() {
+		errorList := ValidateWorkerConfig(
+			&gcp.WorkerConfig{
+				Scheduling: &gcp.Scheduling{
+					OnHostMaintenance: ptr.To("foo"),
+				},
+			},
+			nil,
+		)
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.scheduling.onHostMaintenance"),
+			})),
+		))
+	})
+
+	It("should forbid live migration for VMs with attached gpu", func() {
+		errorList := ValidateWorkerConfig(
+			&gcp.WorkerConfig{
+				GPU: &gcp.GPU{
+					AcceleratorType: "foo",
+					Count:           1},
+				Scheduling: &gcp.Scheduling{
+					OnHostMaintenance: ptr.To("MIGRATE"),
+				},
+			},
+			nil,
+		)
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.scheduling.onHostMaintenance"),
+			})),
+		))
+	})
+
+	It("should allow valid additional network interfaces", func() {
+		errorList := ValidateWorkerConfig(
+			&gcp.WorkerConfig{
+				AdditionalNetworkInterfaces: []gcp.AdditionalNetworkInterface{
+					{Network: "foo", Subnetwork: "foo-subnet", Routes: []string{"10.0.0.0/8"}},
+					{Network: "bar", Subnetwork: "bar-subnet"},
+				},
+			},
+			nil,
+		)
+
+		Expect(errorList).To(BeEmpty())
+	})
+
+	It("should forbid invalid additional network interfaces", func() {
+		errorList := ValidateWorkerConfig(
+			&gcp.WorkerConfig{
+				AdditionalNetworkInterfaces: []gcp.AdditionalNetworkInterface{
+					{Network: "foo", Routes: []string{"10.0.0.0"}},
+					{Network: "foo", Subnetwork: "foo-subnet"},
+					{Subnetwork: "bar-subnet"},
+				},
+			},
+			nil,
+		)
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.additionalNetworkInterfaces[0].subnetwork"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.additionalNetworkInterfaces[0].routes[0]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("providerConfig.additionalNetworkInterfaces[1].network"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.additionalNetworkInterfaces[2].network"),
+			})),
+		))
+	})
+
+	It("should allow a valid node bootstrap", func() {
+		errorList := ValidateWorkerConfig(
+			&gcp.WorkerConfig{
+				Volume: &gcp.Volume{LocalSSDInterface: ptr.To("NVME")},
+				NodeBootstrap: &gcp.NodeBootstrap{
+					LocalSSD:              &gcp.LocalSSDBootstrap{MountPath: "/mnt/local-ssd"},
+					ConcealMetadataServer: ptr.To(true),
+				},
+			},
+			nil,
+		)
+
+		Expect(errorList).To(BeEmpty())
+	})
+
+	It("should forbid formatting local SSDs without NVMe interface or with invalid mount path", func() {
+		errorList := ValidateWorkerConfig(
+			&gcp.WorkerConfig{
+				Volume: &gcp.Volume{LocalSSDInterface: ptr.To("SCSI")},
+				NodeBootstrap: &gcp.NodeBootstrap{
+					LocalSSD: &gcp.LocalSSDBootstrap{MountPath: "mnt/../local-ssd"},
+				},
+			},
+			nil,
+		)
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.nodeBootstrap.localSSD"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.nodeBootstrap.localSSD.mountPath"),
+			})),
+		))
+	})
+
+	DescribeTable("should forbid mount paths of local SSDs outside of /mnt/",
+		func(mountPath string) {
+			errorList := ValidateWorkerConfig(
+				&gcp.WorkerConfig{
+					Volume:        &gcp.Volume{LocalSSDInterface: ptr.To("NVME")},
+					NodeBootstrap: &gcp.NodeBootstrap{LocalSSD: &gcp.LocalSSDBootstrap{MountPath: mountPath}},
+				},
+				nil,
+			)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.nodeBootstrap.localSSD.mountPath"),
+				})),
+			))
+		},
+		Entry("root", "/"),
+		Entry("system directory", "/var"),
+		Entry("kubelet directory", "/var/lib/kubelet"),
+		Entry("mount directory itself", "/mnt"),
+		Entry("escaping the mount directory", "/mnt/../etc"),
+		Entry("similar prefix", "/mntfoo"),
+	)
+
+	It("should require the mount path of local SSDs", func() {
+		errorList := ValidateWorkerConfig(
+			&gcp.WorkerConfig{
+				Volume:        &gcp.Volume{LocalSSDInterface: ptr.To("NVME")},
+				NodeBootstrap: &gcp.NodeBootstrap{LocalSSD: &gcp.LocalSSDBootstrap{}},
+			},
+			nil,
+		)
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.nodeBootstrap.localSSD.mountPath"),
+			})),
+		))
+	})
+
 	It("should allow valid dataVolume name", func() {
 		errorList := validateWorkerConfig([]core.Worker{workers[0]}, &gcp.WorkerConfig{
 			DataVolumes: []gcp.DataVolume{{
@@ -569,6 +752,17 @@ var _ = Describe("#ValidateWorkers", func() {
 			))
 		})
 
+		It("should allow changing the machine type of a worker hosting system components with unavailable machines", func() {
+			workers[0].Machine.Type = "n1-standard-2"
+			newWorkers := copyWorkers(workers)
+			newWorkers[0].Machine.Type = "n2-standard-4"
+			newWorkers[0].Maximum = 4
+			newWorkers[0].MaxUnavailable = ptr.To(intstr.FromString("25%"))
+			errorList := ValidateWorkersUpdate(workers, newWorkers, nilPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
 		It("should forbid adding a zone while changing an existing one", func() {
 			newWorkers := copyWorkers(workers)
 			newWorkers = append(newWorkers, core.Worker{Name: "worker3", Zones: []string{"zone1"}})
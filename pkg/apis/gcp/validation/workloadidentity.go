@@ -46,6 +46,10 @@ func ValidateWorkloadIdentityConfig(config *apisgcp.WorkloadIdentityConfig, fldP
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("projectID"), config.ProjectID, "does not match the expected format"))
 	}
 
+	if len(config.QuotaProject) > 0 && !projectIDRegexp.MatchString(config.QuotaProject) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("quotaProject"), config.QuotaProject, "does not match the expected format"))
+	}
+
 	if config.CredentialsConfig == nil {
 		allErrs = append(allErrs, field.Required(fldPath.Child("credentialsConfig"), "is required"))
 	}
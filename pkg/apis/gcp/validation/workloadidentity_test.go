@@ -50,6 +50,7 @@ var _ = Describe("#ValidateWorkloadIdentityConfig", func() {
 
 	It("should contain all expected validation errors", func() {
 		workloadIdentityConfig.ProjectID = "_invalid"
+		workloadIdentityConfig.QuotaProject = "_invalid"
 		workloadIdentityConfig.CredentialsConfig.Raw = []byte(`
 {
 	"extra": "field",
@@ -89,6 +90,12 @@ var _ = Describe("#ValidateWorkloadIdentityConfig", func() {
 				"BadValue": Equal("_invalid"),
 				"Detail":   Equal("does not match the expected format"),
 			},
+			Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("providerConfig.quotaProject"),
+				"BadValue": Equal("_invalid"),
+				"Detail":   Equal("does not match the expected format"),
+			},
 			Fields{
 				"Type":     Equal(field.ErrorTypeInvalid),
 				"Field":    Equal("providerConfig.credentialsConfig.subject_token_type"),
@@ -71,6 +71,44 @@ func (c *Config) ApplyETCDBackup(etcdBackup *config.ETCDBackup) {
 	*etcdBackup = c.Config.ETCD.Backup
 }
 
+// ApplyPodSecurity sets the given pod security configuration to that of this Config.
+func (c *Config) ApplyPodSecurity(podSecurity **config.PodSecurity) {
+	*podSecurity = c.Config.PodSecurity
+}
+
+// ApplyBastion sets the given Bastion controller configuration to that of this Config.
+func (c *Config) ApplyBastion(bastion **config.BastionConfiguration) {
+	*bastion = c.Config.Bastion
+}
+
+// ApplyAPIEndpoints sets the given GCP API endpoints to that of this Config.
+func (c *Config) ApplyAPIEndpoints(endpoints *config.APIEndpoints) {
+	if c.Config.APIEndpoints != nil {
+		*endpoints = *c.Config.APIEndpoints
+	}
+}
+
+// ApplyComputeRateLimits sets the given rate limits of the Compute Engine API to that of this Config.
+func (c *Config) ApplyComputeRateLimits(limits *config.ComputeRateLimits) {
+	if c.Config.ComputeRateLimits != nil {
+		*limits = *c.Config.ComputeRateLimits
+	}
+}
+
+// ApplyComputeOperations sets the given configuration of the Compute Engine API operations to that of this Config.
+func (c *Config) ApplyComputeOperations(operations *config.ComputeOperations) {
+	if c.Config.ComputeOperations != nil {
+		*operations = *c.Config.ComputeOperations
+	}
+}
+
+// ApplyCABundle sets the given CA bundle of the GCP API clients to that of this Config.
+func (c *Config) ApplyCABundle(caBundle *string) {
+	if c.Config.CABundle != nil {
+		*caBundle = *c.Config.CABundle
+	}
+}
+
 // Options initializes empty config.ControllerConfiguration, applies the set values and returns it.
 func (c *Config) Options() config.ControllerConfiguration {
 	var cfg config.ControllerConfiguration
@@ -59,5 +59,7 @@ func WebhookSwitchOptions() *webhookcmd.SwitchOptions {
 		webhookcmd.Switch(terraformerwebhook.WebhookName, terraformerwebhook.AddToManager),
 		webhookcmd.Switch(infrastructurewebhook.WebhookName, infrastructurewebhook.AddToManager),
 		webhookcmd.Switch(extensionshootwebhook.WebhookName, shootwebhook.AddToManager),
+		webhookcmd.Switch(shootwebhook.ServiceWebhookName, shootwebhook.AddServiceWebhookToManager),
+		webhookcmd.Switch(shootwebhook.PersistentVolumeWebhookName, shootwebhook.AddPersistentVolumeWebhookToManager),
 	)
 }
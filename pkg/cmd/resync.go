@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// ResyncPeriodFlag is the name of the command line flag to specify the resync period of a controller.
+const ResyncPeriodFlag = "resync-period"
+
+// ResyncOptions are command line options that can be set for the resync of the resources of a controller.
+type ResyncOptions struct {
+	// ResyncPeriod is the period after the last successful reconciliation after which the resources are reconciled
+	// again, even if they did not change. Zero disables the resync.
+	ResyncPeriod time.Duration
+
+	config *ResyncConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (r *ResyncOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&r.ResyncPeriod, ResyncPeriodFlag, r.ResyncPeriod, "The period after which resources are reconciled again even if they did not change. Zero disables the resync.")
+}
+
+// Complete implements Completer.Complete.
+func (r *ResyncOptions) Complete() error {
+	if r.ResyncPeriod < 0 {
+		return fmt.Errorf("resync period must not be negative: %s", r.ResyncPeriod)
+	}
+
+	r.config = &ResyncConfig{ResyncPeriod: r.ResyncPeriod}
+	return nil
+}
+
+// Completed returns the completed ResyncConfig. Only call this if `Complete` was successful.
+func (r *ResyncOptions) Completed() *ResyncConfig {
+	return r.config
+}
+
+// ResyncConfig is a completed resync configuration.
+type ResyncConfig struct {
+	// ResyncPeriod is the period after which resources are reconciled again.
+	ResyncPeriod time.Duration
+}
+
+// Apply sets the values of this ResyncConfig in the given period.
+func (r *ResyncConfig) Apply(period *time.Duration) {
+	*period = r.ResyncPeriod
+}
@@ -11,7 +11,6 @@ import (
 
 	"cloud.google.com/go/storage"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -21,6 +20,7 @@ import (
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
@@ -28,6 +28,7 @@ type actuator struct {
 	backupbucket.Actuator
 	client           client.Client
 	gcpClientFactory gcpclient.Factory
+	recorder         *events.Recorder
 }
 
 // NewActuator creates a new Actuator that manages BackupBucket resources.
@@ -35,6 +36,7 @@ func NewActuator(mgr manager.Manager, gcpClientFactory gcpclient.Factory) backup
 	return &actuator{
 		client:           mgr.GetClient(),
 		gcpClientFactory: gcpClientFactory,
+		recorder:         events.NewRecorderForClusters(backupbucket.ControllerName, mgr, nil),
 	}
 }
 
@@ -44,7 +46,7 @@ func (a *actuator) Reconcile(ctx context.Context, logger logr.Logger, bb *extens
 	storageClient, err := a.gcpClientFactory.Storage(ctx, a.client, bb.Spec.SecretRef)
 	if err != nil {
 		logger.Error(err, "Failed to create storage client")
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	var backupBucketConfig *apisgcp.BackupBucketConfig
@@ -59,7 +61,7 @@ func (a *actuator) Reconcile(ctx context.Context, logger logr.Logger, bb *extens
 	attrs, err := storageClient.Attrs(ctx, bb.Name)
 	if err != nil && !errors.Is(err, storage.ErrBucketNotExist) {
 		logger.Error(err, "Failed to fetch bucket attributes")
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	if errors.Is(err, storage.ErrBucketNotExist) {
@@ -67,6 +69,7 @@ func (a *actuator) Reconcile(ctx context.Context, logger logr.Logger, bb *extens
 		if err != nil {
 			return err
 		}
+		a.recorder.Eventf(bb, nil, events.ReasonBucketCreated, "Bucket %q created in %s", bb.Name, bb.Spec.Region)
 	} else if isUpdateRequired(attrs, backupBucketConfig, logger) {
 		attrs, err = updateBucket(ctx, storageClient, bb.Name, backupBucketConfig, logger)
 		if err != nil {
@@ -81,6 +84,7 @@ func (a *actuator) Reconcile(ctx context.Context, logger logr.Logger, bb *extens
 		if err != nil {
 			return err
 		}
+		a.recorder.Eventf(bb, nil, events.ReasonBucketLocked, "Retention policy of bucket %q locked", bb.Name)
 	}
 
 	logger.Info("Reconciliation completed successfully", "name", bb.Name)
@@ -90,10 +94,10 @@ func (a *actuator) Reconcile(ctx context.Context, logger logr.Logger, bb *extens
 func (a *actuator) Delete(ctx context.Context, _ logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
 	storageClient, err := a.gcpClientFactory.Storage(ctx, a.client, bb.Spec.SecretRef)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
-	return util.DetermineError(storageClient.DeleteBucketIfExists(ctx, bb.Name), helper.KnownCodes)
+	return helper.DetermineError(storageClient.DeleteBucketIfExists(ctx, bb.Name))
 }
 
 func createBucket(ctx context.Context, storageClient gcpclient.StorageClient, bb *extensionsv1alpha1.BackupBucket, config *apisgcp.BackupBucketConfig, logger logr.Logger) (*storage.BucketAttrs, error) {
@@ -134,7 +138,7 @@ func createBucket(ctx context.Context, storageClient gcpclient.StorageClient, bb
 
 	if err := storageClient.CreateBucket(ctx, attrs); err != nil {
 		logger.Error(err, "Failed to create bucket", "name", bb.Name)
-		return nil, util.DetermineError(err, helper.KnownCodes)
+		return nil, helper.DetermineError(err)
 	}
 	logger.Info("Bucket created successfully", "name", bb.Name)
 	return attrs, nil
@@ -169,7 +173,7 @@ func updateBucket(ctx context.Context, storageClient gcpclient.StorageClient, bu
 	attrs, err := storageClient.UpdateBucket(ctx, bucketName, updateAttrs)
 	if err != nil {
 		logger.Error(err, "Failed to update bucket", "name", bucketName)
-		return nil, util.DetermineError(err, helper.KnownCodes)
+		return nil, helper.DetermineError(err)
 	}
 	logger.Info("Bucket updated successfully", "name", bucketName)
 	return attrs, nil
@@ -178,7 +182,7 @@ func lockBucket(ctx context.Context, storageClient gcpclient.StorageClient, buck
 	logger.Info("Locking bucket", "name", bucketName)
 	if err := storageClient.LockBucket(ctx, bucketName); err != nil {
 		logger.Error(err, "Failed to lock bucket", "name", bucketName)
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 	logger.Info("Bucket locked successfully", "name", bucketName)
 	return nil
@@ -21,6 +21,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
@@ -40,6 +41,7 @@ var _ = Describe("Actuator", func() {
 		logger           logr.Logger
 		a                backupbucket.Actuator
 		mgr              *mockmanager.MockManager
+		recorder         *record.FakeRecorder
 
 		secretRef             = corev1.SecretReference{Name: "backup-gcp-ha", Namespace: "garden"}
 		bucketName            = "test-bucket"
@@ -69,6 +71,8 @@ var _ = Describe("Actuator", func() {
 		c = mockclient.NewMockClient(ctrl)
 		mgr = mockmanager.NewMockManager(ctrl)
 		mgr.EXPECT().GetClient().Return(c).AnyTimes()
+		recorder = record.NewFakeRecorder(10)
+		mgr.EXPECT().GetEventRecorderFor(gomock.Any()).Return(recorder).AnyTimes()
 		c.EXPECT().Scheme().Return(scheme).MaxTimes(1)
 
 		sw = mockclient.NewMockStatusWriter(ctrl)
@@ -114,6 +118,7 @@ var _ = Describe("Actuator", func() {
 				gcpStorageClient.EXPECT().CreateBucket(ctx, gomock.Any()).Return(nil)
 				err := a.Reconcile(ctx, logger, backupBucket)
 				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).To(Receive(Equal(`Normal BucketCreated Bucket "test-bucket" created in europe-west1`)))
 			})
 
 			It("should return error if creating bucket fails", func() {
@@ -399,6 +404,7 @@ var _ = Describe("Actuator", func() {
 
 				err := a.Reconcile(ctx, logger, backupBucket)
 				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).To(Receive(Equal(`Normal BucketLocked Retention policy of bucket "test-bucket" locked`)))
 			})
 
 			It("should return an error if locking fails", func() {
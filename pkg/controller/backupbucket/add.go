@@ -6,12 +6,16 @@ package backupbucket
 
 import (
 	"context"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/instrumentation"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/resync"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
@@ -29,18 +33,33 @@ type AddOptions struct {
 	IgnoreOperationAnnotation bool
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// ResyncPeriod is the period after which the resources are reconciled again even if they did not change.
+	ResyncPeriod time.Duration
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts AddOptions) error {
-	return backupbucket.Add(mgr, backupbucket.AddArgs{
-		Actuator:          NewActuator(mgr, gcpclient.New()),
-		ControllerOptions: opts.Controller,
-		Predicates:        backupbucket.DefaultPredicates(opts.IgnoreOperationAnnotation),
+	controllerOptions := resync.ControllerOptions(opts.Controller, resync.Args{
+		Reader:            mgr.GetClient(),
+		NewObjectFunc:     func() client.Object { return &extensionsv1alpha1.BackupBucket{} },
+		NewObjectListFunc: func() client.ObjectList { return &extensionsv1alpha1.BackupBucketList{} },
 		Type:              gcp.Type,
 		ExtensionClass:    opts.ExtensionClass,
+		Period:            opts.ResyncPeriod,
 	})
+
+	if err := backupbucket.Add(mgr, backupbucket.AddArgs{
+		Actuator:          instrumentation.NewBackupBucketActuator(NewActuator(mgr, gcpclient.New())),
+		ControllerOptions: controllerOptions,
+		Predicates:        backupbucket.DefaultPredicates(opts.IgnoreOperationAnnotation),
+		Type:              gcp.Type,
+		ExtensionClass:    opts.ExtensionClass,
+	}); err != nil {
+		return err
+	}
+
+	return addImmutabilityController(mgr, opts.ExtensionClass)
 }
 
 // AddToManager adds a controller with the default Options.
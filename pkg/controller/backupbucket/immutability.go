@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission"
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+const (
+	// ConditionTypeImmutabilityCompliant is the type of the condition of BackupBuckets which states whether the
+	// retention policy of the bucket complies with the configured immutability.
+	ConditionTypeImmutabilityCompliant gardencorev1beta1.ConditionType = "ImmutabilityCompliant"
+
+	// EventReasonImmutabilityCompliant is the reason of the events and conditions which state that the retention policy
+	// of a bucket complies with the configured immutability.
+	EventReasonImmutabilityCompliant = "ImmutabilityCompliant"
+	// EventReasonImmutabilityViolated is the reason of the events and conditions which state that the retention policy
+	// of a bucket does not comply with the configured immutability.
+	EventReasonImmutabilityViolated = "ImmutabilityViolated"
+
+	// immutabilityCheckInterval is the interval in which the retention policies of the buckets are verified.
+	immutabilityCheckInterval = time.Hour
+)
+
+// immutabilityReconciler periodically verifies that the retention policies of the buckets still comply with the
+// immutability configured in their BackupBuckets. The BackupBucket reconciler is only triggered by changes of the
+// BackupBucket, hence the verification is done by a separate reconciler.
+type immutabilityReconciler struct {
+	client           client.Client
+	gcpClientFactory gcpclient.Factory
+	recorder         record.EventRecorder
+	clock            clock.Clock
+}
+
+func addImmutabilityController(mgr manager.Manager, extensionClass extensionsv1alpha1.ExtensionClass) error {
+	return builder.ControllerManagedBy(mgr).
+		Named("backupbucket-immutability").
+		For(&extensionsv1alpha1.BackupBucket{}, builder.WithPredicates(
+			extensionspredicate.HasType(gcp.Type),
+			extensionspredicate.HasClass(extensionClass),
+		)).
+		Complete(&immutabilityReconciler{
+			client:           mgr.GetClient(),
+			gcpClientFactory: gcpclient.New(),
+			recorder:         mgr.GetEventRecorderFor("backupbucket-immutability"),
+			clock:            clock.RealClock{},
+		})
+}
+
+// Reconcile verifies the retention policy of the bucket of the BackupBucket, if the BackupBucket configures
+// immutability. The result is recorded in the ImmutabilityCompliant condition of the BackupBucket and, if the bucket
+// is not compliant, in warning events for the BackupBucket and its BackupEntries.
+func (r *immutabilityReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	bb := &extensionsv1alpha1.BackupBucket{}
+	if err := r.client.Get(ctx, request.NamespacedName, bb); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The bucket is neither verified before it has been reconciled successfully nor while it is being deleted.
+	if bb.DeletionTimestamp != nil || bb.Status.ObservedGeneration == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	var config *apisgcp.BackupBucketConfig
+	if bb.Spec.ProviderConfig != nil {
+		var err error
+		config, err = admission.DecodeBackupBucketConfig(serializer.NewCodecFactory(r.client.Scheme(), serializer.EnableStrict).UniversalDecoder(), bb.Spec.ProviderConfig)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to decode provider config: %w", err)
+		}
+	}
+	if config == nil || config.Immutability == nil {
+		return reconcile.Result{}, r.removeCondition(ctx, bb)
+	}
+
+	storageClient, err := r.gcpClientFactory.Storage(ctx, r.client, bb.Spec.SecretRef)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to create storage client: %w", err)
+	}
+
+	attrs, err := storageClient.Attrs(ctx, bb.Name)
+	if err != nil && !errors.Is(err, storage.ErrBucketNotExist) {
+		return reconcile.Result{}, fmt.Errorf("failed to fetch bucket attributes: %w", err)
+	}
+
+	violations := immutabilityViolations(attrs, config.Immutability)
+	if len(violations) > 0 {
+		message := fmt.Sprintf("The retention policy of bucket %q does not comply with the configured immutability: %s", bb.Name, strings.Join(violations, ", "))
+		log.Info("Bucket violates configured immutability", "violations", violations)
+		if err := r.recordViolation(ctx, bb, message); err != nil {
+			return reconcile.Result{}, err
+		}
+	} else {
+		message := fmt.Sprintf("The retention policy of bucket %q complies with the configured immutability", bb.Name)
+		if err := r.updateCondition(ctx, bb, gardencorev1beta1.ConditionTrue, EventReasonImmutabilityCompliant, message); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: immutabilityCheckInterval}, nil
+}
+
+// immutabilityViolations returns the deviations of the given bucket attributes from the given immutability
+// configuration.
+func immutabilityViolations(attrs *storage.BucketAttrs, immutability *apisgcp.ImmutableConfig) []string {
+	if attrs == nil {
+		return []string{"bucket does not exist"}
+	}
+	if attrs.RetentionPolicy == nil {
+		return []string{"bucket has no retention policy"}
+	}
+
+	var violations []string
+	if attrs.RetentionPolicy.RetentionPeriod < immutability.RetentionPeriod.Duration {
+		violations = append(violations, fmt.Sprintf("retention period %s is shorter than %s", attrs.RetentionPolicy.RetentionPeriod, immutability.RetentionPeriod.Duration))
+	}
+	if immutability.Locked && !attrs.RetentionPolicy.IsLocked {
+		violations = append(violations, "retention policy is not locked")
+	}
+	return violations
+}
+
+// recordViolation records the given violation in the condition of the given BackupBucket and in warning events for
+// the BackupBucket and all BackupEntries stored in its bucket.
+func (r *immutabilityReconciler) recordViolation(ctx context.Context, bb *extensionsv1alpha1.BackupBucket, message string) error {
+	r.recorder.Event(bb, corev1.EventTypeWarning, EventReasonImmutabilityViolated, message)
+
+	entries := &extensionsv1alpha1.BackupEntryList{}
+	if err := r.client.List(ctx, entries); err != nil {
+		return fmt.Errorf("failed to list backup entries: %w", err)
+	}
+	for i := range entries.Items {
+		if entries.Items[i].Spec.BucketName == bb.Name {
+			r.recorder.Event(&entries.Items[i], corev1.EventTypeWarning, EventReasonImmutabilityViolated, message)
+		}
+	}
+
+	return r.updateCondition(ctx, bb, gardencorev1beta1.ConditionFalse, EventReasonImmutabilityViolated, message)
+}
+
+func (r *immutabilityReconciler) updateCondition(ctx context.Context, bb *extensionsv1alpha1.BackupBucket, status gardencorev1beta1.ConditionStatus, reason, message string) error {
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.clock, bb.Status.Conditions, ConditionTypeImmutabilityCompliant)
+	if condition.Status == gardencorev1beta1.ConditionFalse && status == gardencorev1beta1.ConditionTrue {
+		r.recorder.Event(bb, corev1.EventTypeNormal, reason, message)
+	}
+
+	patch := client.MergeFrom(bb.DeepCopy())
+	bb.Status.Conditions = v1beta1helper.MergeConditions(bb.Status.Conditions, v1beta1helper.UpdatedConditionWithClock(r.clock, condition, status, reason, message))
+	return r.client.Status().Patch(ctx, bb, patch)
+}
+
+func (r *immutabilityReconciler) removeCondition(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	if v1beta1helper.GetCondition(bb.Status.Conditions, ConditionTypeImmutabilityCompliant) == nil {
+		return nil
+	}
+
+	patch := client.MergeFrom(bb.DeepCopy())
+	bb.Status.Conditions = v1beta1helper.RemoveConditions(bb.Status.Conditions, ConditionTypeImmutabilityCompliant)
+	return r.client.Status().Patch(ctx, bb, patch)
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/storage"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	apisgcpv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	gcpclientfake "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+var _ = Describe("Immutability", func() {
+	const bucketName = "test-bucket"
+
+	var (
+		ctx = context.TODO()
+
+		factory    *gcpclientfake.Factory
+		c          client.Client
+		recorder   *record.FakeRecorder
+		reconciler *immutabilityReconciler
+		request    = reconcile.Request{NamespacedName: client.ObjectKey{Name: bucketName}}
+		bb         *extensionsv1alpha1.BackupBucket
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(apisgcpv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(apisgcp.AddToScheme(scheme)).To(Succeed())
+
+		bb = &extensionsv1alpha1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{Name: bucketName, Generation: 1},
+			Spec: extensionsv1alpha1.BackupBucketSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type: "gcp",
+					ProviderConfig: &runtime.RawExtension{
+						Raw: []byte(`{"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1","kind": "BackupBucketConfig","immutability":{"retentionType":"bucket","retentionPeriod":"24h","locked":true}}`),
+					},
+				},
+				Region:    "europe-west1",
+				SecretRef: corev1.SecretReference{Name: "backup", Namespace: "garden"},
+			},
+			Status: extensionsv1alpha1.BackupBucketStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{ObservedGeneration: 1},
+			},
+		}
+		entry := &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar--uid"},
+			Spec:       extensionsv1alpha1.BackupEntrySpec{BucketName: bucketName},
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(bb, entry).WithStatusSubresource(bb).Build()
+
+		factory = gcpclientfake.NewFactory("project")
+		recorder = record.NewFakeRecorder(10)
+		reconciler = &immutabilityReconciler{
+			client:           c,
+			gcpClientFactory: factory,
+			recorder:         recorder,
+			clock:            testclock.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)),
+		}
+
+		Expect(factory.StorageClient.CreateBucket(ctx, &storage.BucketAttrs{
+			Name:            bucketName,
+			RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: 24 * time.Hour},
+		})).To(Succeed())
+	})
+
+	condition := func() *gardencorev1beta1.Condition {
+		ExpectWithOffset(1, c.Get(ctx, request.NamespacedName, bb)).To(Succeed())
+		return v1beta1helper.GetCondition(bb.Status.Conditions, ConditionTypeImmutabilityCompliant)
+	}
+
+	It("should report a compliant bucket", func() {
+		Expect(factory.StorageClient.LockBucket(ctx, bucketName)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(gardencorev1beta1.ConditionTrue),
+			"Reason": Equal(EventReasonImmutabilityCompliant),
+		})))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should report a violation for the bucket and its entries until it is fixed", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionFalse),
+			"Reason":  Equal(EventReasonImmutabilityViolated),
+			"Message": ContainSubstring("retention policy is not locked"),
+		})))
+		Expect(recorder.Events).To(HaveLen(2))
+		Expect(<-recorder.Events).To(HavePrefix("Warning " + EventReasonImmutabilityViolated))
+		Expect(<-recorder.Events).To(HavePrefix("Warning " + EventReasonImmutabilityViolated))
+
+		Expect(factory.StorageClient.LockBucket(ctx, bucketName)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(condition()).To(HaveField("Status", gardencorev1beta1.ConditionTrue))
+		Expect(<-recorder.Events).To(HavePrefix("Normal " + EventReasonImmutabilityCompliant))
+	})
+
+	It("should report a bucket without retention policy", func() {
+		_, err := factory.StorageClient.UpdateBucket(ctx, bucketName, storage.BucketAttrsToUpdate{RetentionPolicy: &storage.RetentionPolicy{}})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(condition()).To(HaveField("Message", ContainSubstring("bucket has no retention policy")))
+	})
+
+	It("should not verify buckets without immutability", func() {
+		Expect(c.Get(ctx, request.NamespacedName, bb)).To(Succeed())
+		bb.Spec.ProviderConfig = nil
+		Expect(c.Update(ctx, bb)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(condition()).To(BeNil())
+		Expect(factory.StorageClient.Calls("Attrs")).To(BeZero())
+	})
+})
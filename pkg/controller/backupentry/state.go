@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupentry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// bucketState is the state of a BackupEntry. It records the attributes of the bucket the backups of the BackupEntry are
+// stored in, so that it can be verified during the restoration of the BackupEntry on another seed that the backups are
+// still stored in the same bucket.
+type bucketState struct {
+	// Bucket is the name of the bucket.
+	Bucket string `json:"bucket"`
+	// ProjectNumber is the number of the project of the bucket.
+	ProjectNumber uint64 `json:"projectNumber,omitempty"`
+	// Location is the location of the bucket.
+	Location string `json:"location,omitempty"`
+	// Created is the creation time of the bucket.
+	Created time.Time `json:"created"`
+	// RetentionPeriod is the retention period of the objects in the bucket, if the bucket has a retention policy.
+	RetentionPeriod *time.Duration `json:"retentionPeriod,omitempty"`
+	// RetentionPolicyLocked states whether the retention policy of the bucket is locked.
+	RetentionPolicyLocked bool `json:"retentionPolicyLocked,omitempty"`
+}
+
+type stateActuator struct {
+	backupentry.Actuator
+
+	client           client.Client
+	gcpClientFactory gcpclient.Factory
+	recorder         *events.Recorder
+}
+
+// newStateActuator returns a backupentry.Actuator which records the attributes of the bucket of the BackupEntry in its
+// state and verifies them when the BackupEntry is restored, in addition to the operations of the given actuator.
+func newStateActuator(a backupentry.Actuator, c client.Client, gcpClientFactory gcpclient.Factory, recorder *events.Recorder) backupentry.Actuator {
+	return &stateActuator{
+		Actuator:         a,
+		client:           c,
+		gcpClientFactory: gcpClientFactory,
+		recorder:         recorder,
+	}
+}
+
+// Reconcile reconciles the BackupEntry and records the attributes of its bucket.
+func (a *stateActuator) Reconcile(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
+	if err := a.Actuator.Reconcile(ctx, log, be); err != nil {
+		return err
+	}
+	return a.persistState(ctx, be)
+}
+
+// Restore verifies that the bucket recorded in the restored state of the BackupEntry is still the bucket of the
+// BackupEntry before it restores the BackupEntry. Differences are reported in a warning event, as the verification must
+// not wedge the control plane migration.
+func (a *stateActuator) Restore(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
+	a.verifyState(ctx, log, be)
+	if err := a.Actuator.Restore(ctx, log, be); err != nil {
+		return err
+	}
+	return a.persistState(ctx, be)
+}
+
+func (a *stateActuator) bucketAttrs(ctx context.Context, be *extensionsv1alpha1.BackupEntry) (*storage.BucketAttrs, error) {
+	storageClient, err := a.gcpClientFactory.Storage(ctx, a.client, be.Spec.SecretRef)
+	if err != nil {
+		return nil, helper.DetermineError(err)
+	}
+
+	attrs, err := storageClient.Attrs(ctx, be.Spec.BucketName)
+	if err != nil {
+		if errors.Is(err, storage.ErrBucketNotExist) {
+			return nil, nil
+		}
+		return nil, helper.DetermineError(fmt.Errorf("failed to fetch attributes of bucket %s: %w", be.Spec.BucketName, err))
+	}
+	return attrs, nil
+}
+
+func (a *stateActuator) persistState(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	attrs, err := a.bucketAttrs(ctx, be)
+	if err != nil || attrs == nil {
+		return err
+	}
+
+	state := bucketState{
+		Bucket:        attrs.Name,
+		ProjectNumber: attrs.ProjectNumber,
+		Location:      attrs.Location,
+		Created:       attrs.Created.UTC(),
+	}
+	if attrs.RetentionPolicy != nil {
+		state.RetentionPeriod = &attrs.RetentionPolicy.RetentionPeriod
+		state.RetentionPolicyLocked = attrs.RetentionPolicy.IsLocked
+	}
+
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if be.Status.State != nil && bytes.Equal(be.Status.State.Raw, raw) {
+		return nil
+	}
+
+	patch := client.MergeFrom(be.DeepCopy())
+	be.Status.State = &runtime.RawExtension{Raw: raw}
+	return a.client.Status().Patch(ctx, be, patch)
+}
+
+func (a *stateActuator) verifyState(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) {
+	issues, err := a.stateIssues(ctx, be)
+	if err != nil {
+		log.Error(err, "Could not verify the restored state of the BackupEntry")
+		return
+	}
+	if len(issues) == 0 {
+		return
+	}
+
+	log.Info("The bucket of the BackupEntry does not match the restored state", "issues", issues)
+	a.recorder.Warningf(be, nil, events.ReasonRestoredStateMismatch, "The bucket of the BackupEntry does not match the restored state: %s", strings.Join(issues, "; "))
+}
+
+// stateIssues returns the differences between the bucket recorded in the state of the BackupEntry and its current
+// bucket.
+func (a *stateActuator) stateIssues(ctx context.Context, be *extensionsv1alpha1.BackupEntry) ([]string, error) {
+	if be.Status.State == nil || len(be.Status.State.Raw) == 0 {
+		return nil, nil
+	}
+
+	state := &bucketState{}
+	if err := json.Unmarshal(be.Status.State.Raw, state); err != nil || state.Bucket == "" {
+		// The BackupEntry was not reconciled by a version of the extension which records the bucket attributes.
+		return nil, nil
+	}
+
+	if state.Bucket != be.Spec.BucketName {
+		return []string{fmt.Sprintf("the backups of the restored state are stored in bucket %s instead of %s", state.Bucket, be.Spec.BucketName)}, nil
+	}
+
+	attrs, err := a.bucketAttrs(ctx, be)
+	if err != nil {
+		return nil, err
+	}
+	if attrs == nil {
+		return []string{fmt.Sprintf("bucket %s does not exist", state.Bucket)}, nil
+	}
+
+	var issues []string
+	if !attrs.Created.Equal(state.Created) {
+		issues = append(issues, fmt.Sprintf("bucket %s was re-created at %s", state.Bucket, attrs.Created.UTC().Format(time.RFC3339)))
+	}
+	if state.ProjectNumber != 0 && attrs.ProjectNumber != state.ProjectNumber {
+		issues = append(issues, fmt.Sprintf("bucket %s belongs to project %d instead of %d", state.Bucket, attrs.ProjectNumber, state.ProjectNumber))
+	}
+	if state.Location != "" && attrs.Location != state.Location {
+		issues = append(issues, fmt.Sprintf("bucket %s is located in %s instead of %s", state.Bucket, attrs.Location, state.Location))
+	}
+	return issues, nil
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupentry
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
+	gcpclientfake "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+type fakeActuator struct {
+	backupentry.Actuator
+
+	restored bool
+}
+
+func (f *fakeActuator) Reconcile(context.Context, logr.Logger, *extensionsv1alpha1.BackupEntry) error {
+	return nil
+}
+
+func (f *fakeActuator) Migrate(context.Context, logr.Logger, *extensionsv1alpha1.BackupEntry) error {
+	return nil
+}
+
+func (f *fakeActuator) Restore(context.Context, logr.Logger, *extensionsv1alpha1.BackupEntry) error {
+	f.restored = true
+	return nil
+}
+
+var _ = Describe("State", func() {
+	const bucketName = "backup-bucket"
+
+	var (
+		ctx = context.TODO()
+		log = logr.Discard()
+
+		c        client.Client
+		factory  *gcpclientfake.Factory
+		delegate *fakeActuator
+		recorder *record.FakeRecorder
+		actuator backupentry.Actuator
+		be       *extensionsv1alpha1.BackupEntry
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		be = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar--uid"},
+			Spec: extensionsv1alpha1.BackupEntrySpec{
+				BucketName: bucketName,
+				SecretRef:  corev1.SecretReference{Name: "backup", Namespace: "garden"},
+			},
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(be).WithStatusSubresource(be).Build()
+
+		factory = gcpclientfake.NewFactory("project")
+		Expect(factory.StorageClient.CreateBucket(ctx, &storage.BucketAttrs{
+			Name:            bucketName,
+			Location:        "EUROPE-WEST1",
+			ProjectNumber:   42,
+			RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: 24 * time.Hour},
+		})).To(Succeed())
+
+		delegate = &fakeActuator{}
+		recorder = record.NewFakeRecorder(10)
+		actuator = newStateActuator(delegate, c, factory, events.NewRecorder(recorder, nil))
+	})
+
+	state := func() *bucketState {
+		ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(be), be)).To(Succeed())
+		ExpectWithOffset(1, be.Status.State).NotTo(BeNil())
+		s := &bucketState{}
+		ExpectWithOffset(1, json.Unmarshal(be.Status.State.Raw, s)).To(Succeed())
+		return s
+	}
+
+	It("should record the attributes of the bucket", func() {
+		Expect(actuator.Reconcile(ctx, log, be)).To(Succeed())
+
+		s := state()
+		Expect(s.Bucket).To(Equal(bucketName))
+		Expect(s.Location).To(Equal("EUROPE-WEST1"))
+		Expect(s.ProjectNumber).To(Equal(uint64(42)))
+		Expect(s.Created).NotTo(BeZero())
+		Expect(s.RetentionPeriod).NotTo(BeNil())
+	})
+
+	It("should not access the bucket during the migration", func() {
+		Expect(factory.StorageClient.DeleteBucketIfExists(ctx, bucketName)).To(Succeed())
+
+		Expect(actuator.Migrate(ctx, log, be)).To(Succeed())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(be), be)).To(Succeed())
+		Expect(be.Status.State).To(BeNil())
+	})
+
+	It("should restore the BackupEntry if the bucket matches the restored state", func() {
+		Expect(actuator.Reconcile(ctx, log, be)).To(Succeed())
+		Expect(state().Bucket).To(Equal(bucketName))
+
+		Expect(actuator.Restore(ctx, log, be)).To(Succeed())
+		Expect(delegate.restored).To(BeTrue())
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should report a re-created bucket and restore the BackupEntry", func() {
+		Expect(actuator.Reconcile(ctx, log, be)).To(Succeed())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(be), be)).To(Succeed())
+
+		Expect(factory.StorageClient.DeleteBucketIfExists(ctx, bucketName)).To(Succeed())
+		Expect(factory.StorageClient.CreateBucket(ctx, &storage.BucketAttrs{Name: bucketName, Location: "EUROPE-WEST1", ProjectNumber: 42})).To(Succeed())
+
+		Expect(actuator.Restore(ctx, log, be)).To(Succeed())
+		Expect(delegate.restored).To(BeTrue())
+		Expect(recorder.Events).To(Receive(SatisfyAll(HavePrefix("Warning RestoredStateMismatch "), ContainSubstring("was re-created"))))
+	})
+
+	It("should report a missing bucket and restore the BackupEntry", func() {
+		Expect(actuator.Reconcile(ctx, log, be)).To(Succeed())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(be), be)).To(Succeed())
+
+		Expect(factory.StorageClient.DeleteBucketIfExists(ctx, bucketName)).To(Succeed())
+
+		Expect(actuator.Restore(ctx, log, be)).To(Succeed())
+		Expect(delegate.restored).To(BeTrue())
+		Expect(recorder.Events).To(Receive(SatisfyAll(HavePrefix("Warning RestoredStateMismatch "), ContainSubstring("does not exist"))))
+	})
+
+	It("should restore BackupEntries without recorded bucket attributes", func() {
+		Expect(actuator.Restore(ctx, log, be)).To(Succeed())
+		Expect(delegate.restored).To(BeTrue())
+		Expect(state().Bucket).To(Equal(bucketName))
+	})
+})
@@ -9,7 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
@@ -18,6 +18,7 @@ import (
 	"github.com/go-logr/logr"
 	computev1 "google.golang.org/api/compute/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -32,12 +33,20 @@ const (
 )
 
 type actuator struct {
-	client client.Client
+	client              client.Client
+	ingressExpiration   *time.Duration
+	sessionAuditLogging bool
+	diskSize            int64
+	clock               clock.Clock
 }
 
-func newActuator(mgr manager.Manager) bastion.Actuator {
+func newActuator(mgr manager.Manager, ingressExpiration *time.Duration, sessionAuditLogging bool, diskSize int64) bastion.Actuator {
 	return &actuator{
-		client: mgr.GetClient(),
+		client:              mgr.GetClient(),
+		ingressExpiration:   ingressExpiration,
+		sessionAuditLogging: sessionAuditLogging,
+		diskSize:            diskSize,
+		clock:               clock.RealClock{},
 	}
 }
 
@@ -84,13 +93,12 @@ func getWorkersCIDR(cluster *controller.Cluster) (string, error) {
 }
 
 func getDefaultGCPZone(ctx context.Context, client gcpclient.ComputeClient, region string) (string, error) {
-	resp, err := client.GetRegion(ctx, region)
+	zones, err := client.ListZones(ctx, region)
 	if err != nil {
 		return "", err
 	}
-	if len(resp.Zones) > 0 {
-		zone := strings.Split(resp.Zones[0], "/")
-		return zone[(len(zone) - 1)], nil
+	if len(zones) > 0 {
+		return zones[0], nil
 	}
 	return "", fmt.Errorf("no available zones in GCP region: %s", region)
 }
@@ -11,7 +11,6 @@ import (
 	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
@@ -35,7 +34,7 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 
 	gcpClient, err := gcpclient.New().Compute(ctx, a.client, secretReference)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to create GCP client: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to create GCP client: %w", err))
 	}
 
 	infrastructureStatus, subnet, err := getInfrastructureStatus(ctx, a.client, cluster)
@@ -51,17 +50,17 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 	if opt.Zone == "" {
 		opt.Zone, err = getDefaultGCPZone(ctx, gcpClient, cluster.Shoot.Spec.Region)
 		if err != nil {
-			return util.DetermineError(err, helper.KnownCodes)
+			return helper.DetermineError(err)
 		}
 	}
 
 	if err := removeBastionInstance(ctx, log, gcpClient, opt); err != nil {
-		return util.DetermineError(fmt.Errorf("failed to remove bastion instance: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to remove bastion instance: %w", err))
 	}
 
 	deleted, err := isInstanceDeleted(ctx, gcpClient, opt)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to check for bastion instance: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to check for bastion instance: %w", err))
 	}
 
 	if !deleted {
@@ -72,11 +71,11 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 	}
 
 	if err := removeDisk(ctx, log, gcpClient, opt); err != nil {
-		return util.DetermineError(fmt.Errorf("failed to remove disk: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to remove disk: %w", err))
 	}
 
 	if err := removeFirewallRules(ctx, gcpClient, opt); err != nil {
-		return util.DetermineError(fmt.Errorf("failed to remove firewall rule: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to remove firewall rule: %w", err))
 	}
 
 	return nil
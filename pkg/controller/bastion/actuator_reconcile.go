@@ -9,10 +9,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
@@ -27,6 +28,17 @@ import (
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
+const (
+	// sessionAuditStartupScript is appended to the startup script of bastion hosts with session audit logging. It
+	// forwards the logs of the SSH daemon to the serial console, whose output is exported to Cloud Logging.
+	sessionAuditStartupScript = `
+systemd-run --unit=bastion-session-audit --property=StandardOutput=file:/dev/ttyS0 journalctl --follow --output=short-iso --unit=ssh --unit=sshd
+`
+)
+
+// labelValueRegex matches the characters which are not allowed in label values of GCP resources.
+var labelValueRegex = regexp.MustCompile(`[^a-z0-9_-]`)
+
 // bastionEndpoints collects the endpoints the bastion host provides; the
 // private endpoint is important for opening a port on the worker node
 // ingress firewall rule to allow SSH from that node, the public endpoint is where
@@ -55,7 +67,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 
 	gcpClient, err := gcpclient.New().Compute(ctx, a.client, secretReference)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to create GCP client: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to create GCP client: %w", err))
 	}
 
 	infrastructureStatus, subnet, err := getInfrastructureStatus(ctx, a.client, cluster)
@@ -68,10 +80,13 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		return fmt.Errorf("failed to determine Options: %w", err)
 	}
 
+	opt.SessionAuditLogging = a.sessionAuditLogging
+	opt.DiskSize = a.diskSize
+
 	if opt.Zone == "" {
 		opt.Zone, err = getDefaultGCPZone(ctx, gcpClient, cluster.Shoot.Spec.Region)
 		if err != nil {
-			return util.DetermineError(err, helper.KnownCodes)
+			return helper.DetermineError(err)
 		}
 	}
 
@@ -86,14 +101,14 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		return fmt.Errorf("failed to store status.providerStatus for zone: %s", opt.Zone)
 	}
 
-	err = ensureFirewallRules(ctx, log, gcpClient, bastion, opt)
+	err = ensureFirewallRules(ctx, log, gcpClient, bastion, opt, ingressExpired(bastion, a.ingressExpiration, a.clock.Now()))
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to ensure firewall rule: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to ensure firewall rule: %w", err))
 	}
 
 	instance, err := ensureComputeInstance(ctx, log, bastion, gcpClient, opt)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	// check if the instance already exists and has an IP
@@ -118,13 +133,17 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 	return a.client.Status().Patch(ctx, bastion, patch)
 }
 
-func ensureFirewallRules(ctx context.Context, log logr.Logger, client gcpclient.ComputeClient, bastion *extensionsv1alpha1.Bastion, opt *Options) error {
+func ensureFirewallRules(ctx context.Context, log logr.Logger, client gcpclient.ComputeClient, bastion *extensionsv1alpha1.Bastion, opt *Options, ingressExpired bool) error {
 	cidrs, err := ingressPermissions(bastion)
 	if err != nil {
 		return err
 	}
 
 	firewallList := []*compute.Firewall{IngressAllowSSH(opt, cidrs), EgressDenyAll(opt), EgressAllowOnly(opt)}
+	// The SSH ingress of a bastion is not recreated once it has expired.
+	if ingressExpired {
+		firewallList = firewallList[1:]
+	}
 
 	for _, item := range firewallList {
 		if err := createFirewallRuleIfNotExist(ctx, log, client, item); err != nil {
@@ -132,6 +151,10 @@ func ensureFirewallRules(ctx context.Context, log logr.Logger, client gcpclient.
 		}
 	}
 
+	if ingressExpired {
+		return removeIngressFirewallRule(ctx, log, client, opt.BastionInstanceName)
+	}
+
 	firewall, err := client.GetFirewallRule(ctx, IngressAllowSSH(opt, cidrs).Name)
 	if err != nil || firewall == nil {
 		return fmt.Errorf("could not get firewall rule: %w", err)
@@ -231,6 +254,8 @@ func addressToIngress(dnsName *string, ipAddress *string) *corev1.LoadBalancerIn
 	return ingress
 }
 
+// computeInstanceDefine defines the bastion instance. The bastion host does not access any GCP API, hence it is created
+// without a service account and scopes.
 func computeInstanceDefine(opt *Options, userData []byte) *compute.Instance {
 	return &compute.Instance{
 		Disks:              disksDefine(opt),
@@ -241,21 +266,59 @@ func computeInstanceDefine(opt *Options, userData []byte) *compute.Instance {
 		MachineType:        machineTypeDefine(opt),
 		NetworkInterfaces:  networkInterfacesDefine(opt),
 		Tags:               &compute.Tags{Items: []string{opt.BastionInstanceName}},
-		Metadata:           &compute.Metadata{Items: metadataItemsDefine(userData)},
+		Metadata:           &compute.Metadata{Items: metadataItemsDefine(opt, userData)},
+		Labels:             labelsDefine(opt),
 	}
 }
 
-func metadataItemsDefine(userData []byte) []*compute.MetadataItems {
-	return []*compute.MetadataItems{
+func metadataItemsDefine(opt *Options, userData []byte) []*compute.MetadataItems {
+	startupScript := string(userData)
+	if opt.SessionAuditLogging {
+		startupScript += sessionAuditStartupScript
+	}
+
+	items := []*compute.MetadataItems{
 		{
 			Key:   "startup-script",
-			Value: ptr.To(string(userData)),
+			Value: ptr.To(startupScript),
 		},
 		{
 			Key:   "block-project-ssh-keys",
 			Value: ptr.To("TRUE"),
 		},
 	}
+
+	if opt.SessionAuditLogging {
+		items = append(items, &compute.MetadataItems{
+			Key:   "serial-port-logging-enable",
+			Value: ptr.To("TRUE"),
+		})
+	}
+
+	return items
+}
+
+// labelsDefine returns the labels of the bastion instance. With session audit logging, they identify the shoot of the
+// bastion.
+func labelsDefine(opt *Options) map[string]string {
+	if !opt.SessionAuditLogging {
+		return nil
+	}
+
+	return map[string]string{
+		"shoot-name":      labelValue(opt.Shoot.Name),
+		"shoot-namespace": labelValue(opt.Shoot.Namespace),
+	}
+}
+
+// labelValue converts the given string into a valid label value of GCP resources, which consists of at most 63
+// lowercase letters, digits, underscores and dashes.
+func labelValue(value string) string {
+	value = labelValueRegex.ReplaceAllString(strings.ToLower(value), "_")
+	if len(value) > maxLengthForLabelValue {
+		value = value[:maxLengthForLabelValue]
+	}
+	return value
 }
 
 func machineTypeDefine(opt *Options) string {
@@ -277,7 +340,7 @@ func disksDefine(opt *Options) []*compute.AttachedDisk {
 		{
 			AutoDelete: true,
 			Boot:       true,
-			DiskSizeGb: 10,
+			DiskSizeGb: opt.DiskSize,
 			Mode:       "READ_WRITE",
 			InitializeParams: &compute.AttachedDiskInitializeParams{
 				DiskName:    opt.DiskName,
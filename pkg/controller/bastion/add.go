@@ -6,13 +6,17 @@ package bastion
 
 import (
 	"context"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/resync"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
@@ -30,19 +34,56 @@ type AddOptions struct {
 	IgnoreOperationAnnotation bool
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// ResyncPeriod is the period after which the resources are reconciled again even if they did not change.
+	ResyncPeriod time.Duration
+	// Config is the configuration of the Bastion controller.
+	Config *config.BastionConfiguration
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
-	return bastion.Add(mgr, bastion.AddArgs{
-		Actuator:          newActuator(mgr),
-		ConfigValidator:   NewConfigValidator(mgr, log.Log, gcpclient.New()),
-		ControllerOptions: opts.Controller,
-		Predicates:        bastion.DefaultPredicates(opts.IgnoreOperationAnnotation),
+	var (
+		allowUnrestrictedIngress bool
+		ingressExpiration        *time.Duration
+		sessionAuditLogging      bool
+		diskSize                 int64 = defaultDiskSize
+	)
+	if opts.Config != nil {
+		allowUnrestrictedIngress = opts.Config.AllowUnrestrictedIngress
+		sessionAuditLogging = opts.Config.SessionAuditLogging
+		if opts.Config.IngressExpiration != nil {
+			ingressExpiration = &opts.Config.IngressExpiration.Duration
+		}
+		if opts.Config.DiskSize != nil {
+			diskSize = *opts.Config.DiskSize
+		}
+	}
+
+	controllerOptions := resync.ControllerOptions(opts.Controller, resync.Args{
+		Reader:            mgr.GetClient(),
+		NewObjectFunc:     func() client.Object { return &extensionsv1alpha1.Bastion{} },
+		NewObjectListFunc: func() client.ObjectList { return &extensionsv1alpha1.BastionList{} },
 		Type:              gcp.Type,
 		ExtensionClass:    opts.ExtensionClass,
+		Period:            opts.ResyncPeriod,
 	})
+
+	if err := bastion.Add(mgr, bastion.AddArgs{
+		Actuator:          newActuator(mgr, ingressExpiration, sessionAuditLogging, diskSize),
+		ConfigValidator:   NewConfigValidator(mgr, log.Log, gcpclient.New(), allowUnrestrictedIngress),
+		ControllerOptions: controllerOptions,
+		Predicates:        bastion.DefaultPredicates(opts.IgnoreOperationAnnotation),
+		Type:              gcp.Type,
+		ExtensionClass:    opts.ExtensionClass,
+	}); err != nil {
+		return err
+	}
+
+	if ingressExpiration == nil {
+		return nil
+	}
+	return addExpirationController(mgr, opts.ExtensionClass, *ingressExpiration)
 }
 
 // AddToManager adds a controller with the default Options.
@@ -70,6 +70,8 @@ var _ = Describe("Bastion", func() {
 			Expect(options.ProjectID).To(Equal("projectID"))
 			Expect(options.Network).To(Equal("projects/projectID/global/networks/vNet"))
 			Expect(options.WorkersCIDR).To(Equal("10.250.0.0/16"))
+			Expect(options.MachineName).To(Equal("machineName"))
+			Expect(options.ImagePath).To(Equal("/path/to/images"))
 		})
 	})
 
@@ -201,6 +203,36 @@ var _ = Describe("Bastion", func() {
 		})
 	})
 
+	Describe("check session audit logging", func() {
+		BeforeEach(func() {
+			opt = createTestOptions(Options{
+				Shoot: &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "shoot"}},
+			})
+		})
+
+		It("should neither export the serial port output nor label the instance without session audit logging", func() {
+			Expect(metadataItemsDefine(&opt, []byte("user-data"))).To(ConsistOf(
+				&compute.MetadataItems{Key: "startup-script", Value: ptr.To("user-data")},
+				&compute.MetadataItems{Key: "block-project-ssh-keys", Value: ptr.To("TRUE")},
+			))
+			Expect(labelsDefine(&opt)).To(BeNil())
+		})
+
+		It("should export the serial port output and label the instance with session audit logging", func() {
+			opt.SessionAuditLogging = true
+
+			Expect(metadataItemsDefine(&opt, []byte("user-data"))).To(ConsistOf(
+				&compute.MetadataItems{Key: "startup-script", Value: ptr.To("user-data" + sessionAuditStartupScript)},
+				&compute.MetadataItems{Key: "block-project-ssh-keys", Value: ptr.To("TRUE")},
+				&compute.MetadataItems{Key: "serial-port-logging-enable", Value: ptr.To("TRUE")},
+			))
+			Expect(labelsDefine(&opt)).To(Equal(map[string]string{
+				"shoot-name":      "shoot",
+				"shoot-namespace": "garden-dev",
+			}))
+		})
+	})
+
 	Describe("check PatchCIDRs ", func() {
 		It("should return equally", func() {
 			cidrs := []string{"213.69.151.0/24"}
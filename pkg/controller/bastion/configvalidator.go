@@ -8,6 +8,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"net/netip"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -25,17 +27,20 @@ import (
 )
 
 type configValidator struct {
-	client           client.Client
-	gcpClientFactory gcpclient.Factory
-	logger           logr.Logger
+	client                   client.Client
+	gcpClientFactory         gcpclient.Factory
+	logger                   logr.Logger
+	allowUnrestrictedIngress bool
 }
 
-// NewConfigValidator creates a new ConfigValidator.
-func NewConfigValidator(mgr manager.Manager, logger logr.Logger, gcpClientFactory gcpclient.Factory) bastion.ConfigValidator {
+// NewConfigValidator creates a new ConfigValidator. Unless allowUnrestrictedIngress is true, bastions whose ingress
+// permits SSH connections from any IP address are rejected.
+func NewConfigValidator(mgr manager.Manager, logger logr.Logger, gcpClientFactory gcpclient.Factory, allowUnrestrictedIngress bool) bastion.ConfigValidator {
 	return &configValidator{
-		client:           mgr.GetClient(),
-		gcpClientFactory: gcpClientFactory,
-		logger:           logger.WithName("gcp-bastion-config-validator"),
+		client:                   mgr.GetClient(),
+		gcpClientFactory:         gcpClientFactory,
+		logger:                   logger.WithName("gcp-bastion-config-validator"),
+		allowUnrestrictedIngress: allowUnrestrictedIngress,
 	}
 }
 
@@ -66,10 +71,78 @@ func (c *configValidator) Validate(ctx context.Context, bastion *extensionsv1alp
 	// Validate bastion config
 	logger.Info("Validating bastion configuration")
 	allErrs = append(allErrs, c.validateInfrastructureStatus(ctx, computeClient, cluster.Shoot.Spec.Region, infrastructureStatus, subnet)...)
+	allErrs = append(allErrs, validateIngress(bastion, c.allowUnrestrictedIngress)...)
 
 	return allErrs
 }
 
+// validateIngress validates the ingress CIDRs of the given bastion. CIDRs which permit SSH connections from any IP
+// address, on their own or together, are only allowed if allowUnrestrictedIngress is true.
+func validateIngress(bastion *extensionsv1alpha1.Bastion, allowUnrestrictedIngress bool) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		prefixes []netip.Prefix
+	)
+
+	for i, ingress := range bastion.Spec.Ingress {
+		cidrPath := field.NewPath("spec", "ingress").Index(i).Child("ipBlock", "cidr")
+
+		_, ipNet, err := net.ParseCIDR(ingress.IPBlock.CIDR)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(cidrPath, ingress.IPBlock.CIDR, fmt.Sprintf("invalid CIDR: %v", err)))
+			continue
+		}
+
+		if ones, _ := ipNet.Mask.Size(); ones == 0 && !allowUnrestrictedIngress {
+			allErrs = append(allErrs, field.Forbidden(cidrPath, "ingress from any IP address is not allowed, the CIDR must be restricted to the addresses of the clients"))
+			continue
+		}
+
+		if prefix, err := netip.ParsePrefix(ipNet.String()); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+		}
+	}
+
+	if !allowUnrestrictedIngress && len(allErrs) == 0 {
+		for _, addressSpace := range []netip.Prefix{netip.PrefixFrom(netip.IPv4Unspecified(), 0), netip.PrefixFrom(netip.IPv6Unspecified(), 0)} {
+			if coversPrefix(addressSpace, prefixes) {
+				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "ingress"), "ingress from any IP address is not allowed, the CIDRs must together be restricted to the addresses of the clients"))
+				break
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// coversPrefix returns true if the given prefixes together contain all addresses of the prefix p.
+func coversPrefix(p netip.Prefix, prefixes []netip.Prefix) bool {
+	longest := 0
+	for _, q := range prefixes {
+		if q.Bits() <= p.Bits() && q.Contains(p.Addr()) {
+			return true
+		}
+		if q.Overlaps(p) {
+			longest = max(longest, q.Bits())
+		}
+	}
+	// Only prefixes which are longer than p can cover parts of it, so p is split into its halves.
+	if p.Bits() >= longest {
+		return false
+	}
+
+	lower, upper := splitPrefix(p)
+	return coversPrefix(lower, prefixes) && coversPrefix(upper, prefixes)
+}
+
+// splitPrefix splits the given masked prefix into its lower and upper half.
+func splitPrefix(p netip.Prefix) (netip.Prefix, netip.Prefix) {
+	addr := p.Addr().AsSlice()
+	addr[p.Bits()/8] |= 0x80 >> (p.Bits() % 8)
+	upper, _ := netip.AddrFromSlice(addr)
+	return netip.PrefixFrom(p.Addr(), p.Bits()+1), netip.PrefixFrom(upper, p.Bits()+1)
+}
+
 func getInfrastructureStatus(ctx context.Context, c client.Client, cluster *extensions.Cluster) (*gcp.InfrastructureStatus, string, error) {
 	var infrastructureStatus *gcp.InfrastructureStatus
 	var nodeSubnet string
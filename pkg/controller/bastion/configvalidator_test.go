@@ -19,6 +19,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/api/compute/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -63,7 +64,7 @@ var _ = Describe("ConfigValidator", func() {
 
 		mgr = mockmanager.NewMockManager(ctrl)
 		mgr.EXPECT().GetClient().Return(c)
-		cv = NewConfigValidator(mgr, logger, gcpClientFactory)
+		cv = NewConfigValidator(mgr, logger, gcpClientFactory, false)
 
 		bastion = &extensionsv1alpha1.Bastion{}
 		cluster = &extensions.Cluster{}
@@ -124,6 +125,63 @@ var _ = Describe("ConfigValidator", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
+		It("should fail if the ingress permits SSH connections from any IP address", func() {
+			bastion.Spec.Ingress = []extensionsv1alpha1.BastionIngressPolicy{
+				{IPBlock: networkingv1.IPBlock{CIDR: "213.69.151.0/24"}},
+				{IPBlock: networkingv1.IPBlock{CIDR: "0.0.0.0/0"}},
+			}
+			gcpComputeClient.EXPECT().GetNetwork(ctx, name).Return(&compute.Network{Name: name}, nil)
+			gcpComputeClient.EXPECT().GetSubnet(ctx, region, name).Return(&compute.Subnetwork{Name: name}, nil)
+			errorList := cv.Validate(ctx, bastion, cluster)
+			Expect(errorList).To(ConsistOfFields(
+				gstruct.Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.ingress[1].ipBlock.cidr"),
+				}))
+		})
+
+		It("should fail if the ingress CIDRs together permit SSH connections from any IP address", func() {
+			bastion.Spec.Ingress = []extensionsv1alpha1.BastionIngressPolicy{
+				{IPBlock: networkingv1.IPBlock{CIDR: "0.0.0.0/1"}},
+				{IPBlock: networkingv1.IPBlock{CIDR: "128.0.0.0/2"}},
+				{IPBlock: networkingv1.IPBlock{CIDR: "192.0.0.0/2"}},
+				{IPBlock: networkingv1.IPBlock{CIDR: "2001:db8::/32"}},
+			}
+			gcpComputeClient.EXPECT().GetNetwork(ctx, name).Return(&compute.Network{Name: name}, nil)
+			gcpComputeClient.EXPECT().GetSubnet(ctx, region, name).Return(&compute.Subnetwork{Name: name}, nil)
+			errorList := cv.Validate(ctx, bastion, cluster)
+			Expect(errorList).To(ConsistOfFields(
+				gstruct.Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.ingress"),
+				}))
+		})
+
+		It("should succeed if the ingress CIDRs do not cover all IP addresses", func() {
+			bastion.Spec.Ingress = []extensionsv1alpha1.BastionIngressPolicy{
+				{IPBlock: networkingv1.IPBlock{CIDR: "0.0.0.0/1"}},
+				{IPBlock: networkingv1.IPBlock{CIDR: "128.0.0.0/2"}},
+				{IPBlock: networkingv1.IPBlock{CIDR: "192.0.0.0/3"}},
+				{IPBlock: networkingv1.IPBlock{CIDR: "::/1"}},
+			}
+			gcpComputeClient.EXPECT().GetNetwork(ctx, name).Return(&compute.Network{Name: name}, nil)
+			gcpComputeClient.EXPECT().GetSubnet(ctx, region, name).Return(&compute.Subnetwork{Name: name}, nil)
+			errorList := cv.Validate(ctx, bastion, cluster)
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should succeed if the ingress permits SSH connections from any IP address and it is allowed", func() {
+			mgr.EXPECT().GetClient().Return(c)
+			cv = NewConfigValidator(mgr, logger, gcpClientFactory, true)
+			bastion.Spec.Ingress = []extensionsv1alpha1.BastionIngressPolicy{
+				{IPBlock: networkingv1.IPBlock{CIDR: "0.0.0.0/0"}},
+			}
+			gcpComputeClient.EXPECT().GetNetwork(ctx, name).Return(&compute.Network{Name: name}, nil)
+			gcpComputeClient.EXPECT().GetSubnet(ctx, region, name).Return(&compute.Subnetwork{Name: name}, nil)
+			errorList := cv.Validate(ctx, bastion, cluster)
+			Expect(errorList).To(BeEmpty())
+		})
+
 		It("should fail with InternalError if getting vpc failed", func() {
 			gcpComputeClient.EXPECT().GetNetwork(ctx, name).Return(nil, nil)
 			errorList := cv.Validate(ctx, bastion, cluster)
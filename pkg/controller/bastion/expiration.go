@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// expirationReconciler removes the SSH ingress firewall rule of bastions once their ingress has expired. The Bastion
+// reconciler is only triggered by changes of the Bastion, hence the expiration is handled by a separate reconciler.
+type expirationReconciler struct {
+	client            client.Client
+	gcpClientFactory  gcpclient.Factory
+	ingressExpiration time.Duration
+	clock             clock.Clock
+}
+
+func addExpirationController(mgr manager.Manager, extensionClass extensionsv1alpha1.ExtensionClass, ingressExpiration time.Duration) error {
+	return builder.ControllerManagedBy(mgr).
+		Named("bastion-expiration").
+		For(&extensionsv1alpha1.Bastion{}, builder.WithPredicates(
+			extensionspredicate.HasType(gcp.Type),
+			extensionspredicate.HasClass(extensionClass),
+		)).
+		Complete(&expirationReconciler{
+			client:            mgr.GetClient(),
+			gcpClientFactory:  gcpclient.New(),
+			ingressExpiration: ingressExpiration,
+			clock:             clock.RealClock{},
+		})
+}
+
+// Reconcile removes the SSH ingress firewall rule of the bastion if its ingress has expired. Otherwise, the bastion is
+// requeued for the time of the expiration.
+func (r *expirationReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	bastion := &extensionsv1alpha1.Bastion{}
+	if err := r.client.Get(ctx, request.NamespacedName, bastion); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The firewall rules of deleted bastions are removed by the Bastion reconciler.
+	if bastion.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	if remaining := bastion.CreationTimestamp.Add(r.ingressExpiration).Sub(r.clock.Now()); remaining > 0 {
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	// The Cluster of a bastion is named after its namespace, like the resources of the bastion.
+	baseResourceName, err := generateBastionBaseResourceName(bastion.Namespace, bastion.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	computeClient, err := r.gcpClientFactory.Compute(ctx, r.client, corev1.SecretReference{
+		Namespace: bastion.Namespace,
+		Name:      v1beta1constants.SecretNameCloudProvider,
+	})
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to create GCP client: %w", err)
+	}
+
+	if err := removeIngressFirewallRule(ctx, log, computeClient, baseResourceName); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to remove expired ingress firewall rule: %w", err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// ingressExpired returns true if the SSH ingress of the given bastion has expired at the given time.
+func ingressExpired(bastion *extensionsv1alpha1.Bastion, ingressExpiration *time.Duration, now time.Time) bool {
+	return ingressExpiration != nil && !now.Before(bastion.CreationTimestamp.Add(*ingressExpiration))
+}
+
+// removeIngressFirewallRule removes the SSH ingress firewall rule of the bastion with the given base resource name.
+func removeIngressFirewallRule(ctx context.Context, log logr.Logger, client gcpclient.ComputeClient, baseResourceName string) error {
+	name := FirewallIngressAllowSSHResourceName(baseResourceName)
+
+	firewall, err := client.GetFirewallRule(ctx, name)
+	if err != nil {
+		return err
+	}
+	if firewall == nil {
+		return nil
+	}
+
+	if err := client.DeleteFirewallRule(ctx, name); err != nil {
+		return err
+	}
+
+	log.Info("Expired ingress firewall rule removed", "firewall", name)
+	return nil
+}
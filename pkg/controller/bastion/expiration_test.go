@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Expiration", func() {
+	var (
+		ctx = context.TODO()
+
+		ctrl             *gomock.Controller
+		c                *mockclient.MockClient
+		gcpClientFactory *mockgcpclient.MockFactory
+		gcpComputeClient *mockgcpclient.MockComputeClient
+		fakeClock        *testclock.FakeClock
+
+		reconciler *expirationReconciler
+		request    reconcile.Request
+		created    time.Time
+		ruleName   string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+		gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
+		gcpComputeClient = mockgcpclient.NewMockComputeClient(ctrl)
+
+		created = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		fakeClock = testclock.NewFakeClock(created)
+
+		reconciler = &expirationReconciler{
+			client:            c,
+			gcpClientFactory:  gcpClientFactory,
+			ingressExpiration: time.Hour,
+			clock:             fakeClock,
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: name}}
+
+		baseResourceName, err := generateBastionBaseResourceName(namespace, name)
+		Expect(err).NotTo(HaveOccurred())
+		ruleName = FirewallIngressAllowSSHResourceName(baseResourceName)
+
+		c.EXPECT().Get(ctx, request.NamespacedName, gomock.AssignableToTypeOf(&extensionsv1alpha1.Bastion{})).DoAndReturn(
+			func(_ context.Context, _ client.ObjectKey, obj *extensionsv1alpha1.Bastion, _ ...client.GetOption) error {
+				obj.ObjectMeta = metav1.ObjectMeta{Namespace: namespace, Name: name, CreationTimestamp: metav1.NewTime(created)}
+				return nil
+			})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should requeue the bastion until its ingress expires", func() {
+		fakeClock.Step(20 * time.Minute)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 40 * time.Minute}))
+	})
+
+	It("should remove the ingress firewall rule once the ingress has expired", func() {
+		fakeClock.Step(time.Hour)
+		gcpClientFactory.EXPECT().Compute(ctx, c, corev1.SecretReference{Namespace: namespace, Name: v1beta1constants.SecretNameCloudProvider}).Return(gcpComputeClient, nil)
+		gcpComputeClient.EXPECT().GetFirewallRule(ctx, ruleName).Return(&compute.Firewall{Name: ruleName}, nil)
+		gcpComputeClient.EXPECT().DeleteFirewallRule(ctx, ruleName).Return(nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the expired ingress firewall rule is already removed", func() {
+		fakeClock.Step(2 * time.Hour)
+		gcpClientFactory.EXPECT().Compute(ctx, c, corev1.SecretReference{Namespace: namespace, Name: v1beta1constants.SecretNameCloudProvider}).Return(gcpComputeClient, nil)
+		gcpComputeClient.EXPECT().GetFirewallRule(ctx, ruleName).Return(nil, nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+})
@@ -27,6 +27,12 @@ import (
 const maxLengthForBaseName = 33
 const maxLengthForResource = 63
 
+// maxLengthForLabelValue is the maximum length of label values of GCP resources.
+const maxLengthForLabelValue = 63
+
+// defaultDiskSize is the default size of the root disk of the bastion host in GB.
+const defaultDiskSize = 10
+
 // Options contains provider-related information required for setting up
 // a bastion instance. This struct combines precomputed values like the
 // bastion instance name with the IDs of pre-existing cloud provider
@@ -42,6 +48,8 @@ type Options struct {
 	WorkersCIDR         string
 	ImagePath           string
 	MachineName         string
+	DiskSize            int64
+	SessionAuditLogging bool
 }
 
 type providerStatusRaw struct {
@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"google.golang.org/api/compute/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	apisgcpv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// labelClusterName is the label on the storage pools which identifies the shoot they belong to. It is the same label
+// that is added to the instances and disks of the shoot.
+const labelClusterName = "k8s-cluster-name"
+
+type actuator struct {
+	controlplane.Actuator
+
+	client           k8sclient.Client
+	gcpClientFactory gcpclient.Factory
+}
+
+// NewActuator creates a new controlplane.Actuator which manages the storage pools of the shoot in addition to the
+// control plane components deployed by the given actuator.
+func NewActuator(mgr manager.Manager, a controlplane.Actuator, gcpClientFactory gcpclient.Factory) controlplane.Actuator {
+	return &actuator{
+		Actuator:         a,
+		client:           mgr.GetClient(),
+		gcpClientFactory: gcpClientFactory,
+	}
+}
+
+// Reconcile creates the storage pools before the control plane components are reconciled, so that they can be
+// referenced by the StorageClasses. If a KMS key is configured, the kube-apiserver is switched to the encryption
+// configuration with the KMS provider afterwards.
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	cpConfig, status, err := decodeControlPlane(cp)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		desiredPools       = desiredStoragePools(cpConfig)
+		manageStoragePools = len(desiredPools) > 0 || len(status.StoragePools) > 0
+		computeClient      gcpclient.ComputeClient
+		existingPools      []*compute.StoragePool
+	)
+
+	// Storage pools are only listed for shoots which use them, so that other shoots do not need the permission.
+	if manageStoragePools {
+		computeClient, err = a.gcpClientFactory.Compute(ctx, a.client, cp.Spec.SecretRef)
+		if err != nil {
+			return false, helper.DetermineError(err)
+		}
+
+		existingPools, err = listStoragePools(ctx, computeClient, cp.Namespace)
+		if err != nil {
+			return false, helper.DetermineError(err)
+		}
+
+		if err := reconcileStoragePools(ctx, log, computeClient, cp.Namespace, desiredPools, existingPools); err != nil {
+			return false, helper.DetermineError(err)
+		}
+	}
+
+	requeue, err := a.Actuator.Reconcile(ctx, log, cp, cluster)
+	if err != nil {
+		return requeue, err
+	}
+
+	if cpConfig.KMS != nil {
+		if err := ensureKubeAPIServerUsesKMSEncryption(ctx, a.client, cp.Namespace); err != nil {
+			return requeue, fmt.Errorf("failed switching the kube-apiserver to the encryption configuration with the KMS provider: %w", err)
+		}
+	}
+
+	if !manageStoragePools {
+		return requeue, nil
+	}
+
+	// Storage pools are only deleted after the StorageClasses referencing them were updated.
+	inUsePools, err := deleteStoragePools(ctx, log, computeClient, cp.Namespace, existingPools, desiredPools, false)
+	if err != nil {
+		return requeue, helper.DetermineError(err)
+	}
+
+	recordedPools := inUsePools
+	for _, pool := range desiredPools {
+		recordedPools = append(recordedPools, storagePoolName(cp.Namespace, pool.Name))
+	}
+	return requeue, a.updateStatus(ctx, cp, status, recordedPools)
+}
+
+// Delete deletes the storage pools after the control plane components were deleted.
+func (a *actuator) Delete(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	if err := a.Actuator.Delete(ctx, log, cp, cluster); err != nil {
+		return err
+	}
+
+	cpConfig, status, err := decodeControlPlane(cp)
+	if err != nil {
+		return err
+	}
+
+	if len(desiredStoragePools(cpConfig)) == 0 && len(status.StoragePools) == 0 {
+		return nil
+	}
+
+	computeClient, err := a.gcpClientFactory.Compute(ctx, a.client, cp.Spec.SecretRef)
+	if err != nil {
+		return helper.DetermineError(err)
+	}
+
+	existingPools, err := listStoragePools(ctx, computeClient, cp.Namespace)
+	if err != nil {
+		return helper.DetermineError(err)
+	}
+
+	_, err = deleteStoragePools(ctx, log, computeClient, cp.Namespace, existingPools, nil, true)
+	return helper.DetermineError(err)
+}
+
+func decodeControlPlane(cp *extensionsv1alpha1.ControlPlane) (*apisgcp.ControlPlaneConfig, *apisgcp.ControlPlaneStatus, error) {
+	cpConfig, err := helper.ControlPlaneConfigFromControlPlane(cp)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", k8sclient.ObjectKeyFromObject(cp), err)
+	}
+
+	status, err := helper.ControlPlaneStatusFromControlPlane(cp)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not decode providerStatus of controlplane '%s': %w", k8sclient.ObjectKeyFromObject(cp), err)
+	}
+
+	return cpConfig, status, nil
+}
+
+func desiredStoragePools(cpConfig *apisgcp.ControlPlaneConfig) []apisgcp.StoragePool {
+	if cpConfig.Storage == nil {
+		return nil
+	}
+	return cpConfig.Storage.StoragePools
+}
+
+// updateStatus records the names of the storage pools which exist for the shoot in the provider status of the
+// ControlPlane, so that they are deleted even if they are removed from the configuration.
+func (a *actuator) updateStatus(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, status *apisgcp.ControlPlaneStatus, storagePools []string) error {
+	slices.Sort(storagePools)
+	if slices.Equal(status.StoragePools, storagePools) {
+		return nil
+	}
+
+	patch := k8sclient.MergeFrom(cp.DeepCopy())
+	cp.Status.ProviderStatus = &runtime.RawExtension{Object: &apisgcpv1alpha1.ControlPlaneStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apisgcpv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "ControlPlaneStatus",
+		},
+		StoragePools: storagePools,
+	}}
+	return a.client.Status().Patch(ctx, cp, patch)
+}
+
+// storagePoolName returns the name of the storage pool in GCP.
+func storagePoolName(namespace, name string) string {
+	return namespace + "-" + name
+}
+
+func listStoragePools(ctx context.Context, computeClient gcpclient.ComputeClient, namespace string) ([]*compute.StoragePool, error) {
+	pools, err := computeClient.ListStoragePools(ctx, gcpclient.StoragePoolListOpts{
+		Filter: fmt.Sprintf("labels.%s = %q", labelClusterName, worker.SanitizeGcpLabelValue(namespace)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list storage pools: %w", err)
+	}
+
+	// The label value is sanitized, hence the name prefix is checked as well.
+	var result []*compute.StoragePool
+	for _, pool := range pools {
+		if strings.HasPrefix(pool.Name, namespace+"-") {
+			result = append(result, pool)
+		}
+	}
+	return result, nil
+}
+
+func reconcileStoragePools(ctx context.Context, log logr.Logger, computeClient gcpclient.ComputeClient, namespace string, desiredPools []apisgcp.StoragePool, existingPools []*compute.StoragePool) error {
+	existing := make(map[string]*compute.StoragePool, len(existingPools))
+	for _, pool := range existingPools {
+		existing[pool.Name] = pool
+	}
+
+	for _, pool := range desiredPools {
+		desired := &compute.StoragePool{
+			Name:                      storagePoolName(namespace, pool.Name),
+			StoragePoolType:           pool.Type,
+			CapacityProvisioningType:  "STANDARD",
+			PoolProvisionedCapacityGb: pool.ProvisionedCapacity,
+			PoolProvisionedThroughput: pool.ProvisionedThroughput,
+			Labels: map[string]string{
+				labelClusterName: worker.SanitizeGcpLabelValue(namespace),
+			},
+		}
+		if pool.ProvisionedIops != nil {
+			desired.PoolProvisionedIops = *pool.ProvisionedIops
+		}
+
+		current, ok := existing[desired.Name]
+		if !ok {
+			log.Info("Creating storage pool", "name", desired.Name, "zone", pool.Zone)
+			if _, err := computeClient.InsertStoragePool(ctx, pool.Zone, desired); err != nil {
+				return fmt.Errorf("could not create storage pool %s: %w", desired.Name, err)
+			}
+			continue
+		}
+
+		if current.PoolProvisionedCapacityGb != desired.PoolProvisionedCapacityGb ||
+			current.PoolProvisionedIops != desired.PoolProvisionedIops ||
+			current.PoolProvisionedThroughput != desired.PoolProvisionedThroughput {
+			log.Info("Updating storage pool", "name", desired.Name, "zone", pool.Zone)
+			if _, err := computeClient.UpdateStoragePool(ctx, pool.Zone, desired.Name, desired); err != nil {
+				return fmt.Errorf("could not update storage pool %s: %w", desired.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteStoragePools deletes the existing storage pools which are not desired anymore. Storage pools which still
+// contain disks are only deleted once the disks are gone, unless failOnInUse is set. The names of the storage pools
+// which could not be deleted because they are still in use are returned.
+func deleteStoragePools(ctx context.Context, log logr.Logger, computeClient gcpclient.ComputeClient, namespace string, existingPools []*compute.StoragePool, desiredPools []apisgcp.StoragePool, failOnInUse bool) ([]string, error) {
+	desired := make(map[string]struct{}, len(desiredPools))
+	for _, pool := range desiredPools {
+		desired[storagePoolName(namespace, pool.Name)] = struct{}{}
+	}
+
+	var inUse []string
+	for _, pool := range existingPools {
+		if _, ok := desired[pool.Name]; ok {
+			continue
+		}
+
+		zone := pool.Zone[strings.LastIndex(pool.Zone, "/")+1:]
+
+		log.Info("Deleting storage pool", "name", pool.Name, "zone", zone)
+		if err := computeClient.DeleteStoragePool(ctx, zone, pool.Name); err != nil {
+			if gcpclient.IsResourceInUseError(err) && !failOnInUse {
+				log.Info("Storage pool still contains disks, deletion is retried with the next reconciliation", "name", pool.Name)
+				inUse = append(inUse, pool.Name)
+				continue
+			}
+			return nil, fmt.Errorf("could not delete storage pool %s: %w", pool.Name, err)
+		}
+	}
+
+	return inUse, nil
+}
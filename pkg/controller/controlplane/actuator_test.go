@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
+	mockcontrolplane "github.com/gardener/gardener/extensions/pkg/controller/controlplane/mock"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	apisgcpv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctrl             *gomock.Controller
+		ctx              = context.TODO()
+		logger           logr.Logger
+		c                *mockclient.MockClient
+		sw               *mockclient.MockStatusWriter
+		mgr              *mockmanager.MockManager
+		genericActuator  *mockcontrolplane.MockActuator
+		gcpClientFactory *mockgcpclient.MockFactory
+		gcpComputeClient *mockgcpclient.MockComputeClient
+		a                controlplane.Actuator
+
+		cp       *extensionsv1alpha1.ControlPlane
+		listOpts = gcpclient.StoragePoolListOpts{Filter: `labels.k8s-cluster-name = "test"`}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		logger = log.Log.WithName("test")
+
+		c = mockclient.NewMockClient(ctrl)
+		sw = mockclient.NewMockStatusWriter(ctrl)
+		mgr = mockmanager.NewMockManager(ctrl)
+		mgr.EXPECT().GetClient().Return(c)
+		genericActuator = mockcontrolplane.NewMockActuator(ctrl)
+		gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
+		gcpComputeClient = mockgcpclient.NewMockComputeClient(ctrl)
+
+		a = NewActuator(mgr, genericActuator, gcpClientFactory)
+
+		cp = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "control-plane",
+				Namespace: namespace,
+			},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				SecretRef: corev1.SecretReference{
+					Name:      "cloudprovider",
+					Namespace: namespace,
+				},
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					ProviderConfig: &runtime.RawExtension{
+						Raw: encode(&apisgcp.ControlPlaneConfig{
+							Zone: "europe-west1-b",
+							Storage: &apisgcp.Storage{
+								StoragePools: []apisgcp.StoragePool{
+									{Name: "pool-a", Zone: "europe-west1-b", Type: "hyperdisk-balanced", ProvisionedCapacity: 10240, ProvisionedIops: ptr.To[int64](10000), ProvisionedThroughput: 1024},
+									{Name: "pool-b", Zone: "europe-west1-c", Type: "hyperdisk-throughput", ProvisionedCapacity: 10240, ProvisionedThroughput: 1024},
+								},
+							},
+						}),
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Reconcile", func() {
+		It("should create, update and delete the storage pools and record them in the status", func() {
+			gcpClientFactory.EXPECT().Compute(ctx, c, cp.Spec.SecretRef).Return(gcpComputeClient, nil)
+			gcpComputeClient.EXPECT().ListStoragePools(ctx, listOpts).Return([]*compute.StoragePool{
+				{Name: "test-pool-b", Zone: "https://www.googleapis.com/compute/v1/projects/foo/zones/europe-west1-c", PoolProvisionedCapacityGb: 5120, PoolProvisionedThroughput: 1024},
+				{Name: "test-pool-c", Zone: "https://www.googleapis.com/compute/v1/projects/foo/zones/europe-west1-d"},
+				{Name: "other-pool", Zone: "https://www.googleapis.com/compute/v1/projects/foo/zones/europe-west1-d"},
+			}, nil)
+
+			gomock.InOrder(
+				gcpComputeClient.EXPECT().InsertStoragePool(ctx, "europe-west1-b", &compute.StoragePool{
+					Name:                      "test-pool-a",
+					StoragePoolType:           "hyperdisk-balanced",
+					CapacityProvisioningType:  "STANDARD",
+					PoolProvisionedCapacityGb: 10240,
+					PoolProvisionedIops:       10000,
+					PoolProvisionedThroughput: 1024,
+					Labels:                    map[string]string{"k8s-cluster-name": "test"},
+				}),
+				gcpComputeClient.EXPECT().UpdateStoragePool(ctx, "europe-west1-c", "test-pool-b", gomock.Any()),
+				genericActuator.EXPECT().Reconcile(ctx, logger, cp, nil).Return(false, nil),
+				gcpComputeClient.EXPECT().DeleteStoragePool(ctx, "europe-west1-d", "test-pool-c"),
+				c.EXPECT().Status().Return(sw),
+				sw.EXPECT().Patch(ctx, cp, gomock.Any()),
+			)
+
+			requeue, err := a.Reconcile(ctx, logger, cp, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requeue).To(BeFalse())
+
+			Expect(cp.Status.ProviderStatus.Object).To(Equal(&apisgcpv1alpha1.ControlPlaneStatus{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "gcp.provider.extensions.gardener.cloud/v1alpha1",
+					Kind:       "ControlPlaneStatus",
+				},
+				StoragePools: []string{"test-pool-a", "test-pool-b"},
+			}))
+		})
+
+		It("should not list the storage pools if none are configured or recorded in the status", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{Zone: "europe-west1-b"})
+
+			genericActuator.EXPECT().Reconcile(ctx, logger, cp, nil).Return(false, nil)
+
+			_, err := a.Reconcile(ctx, logger, cp, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should keep a recorded storage pool which still contains disks in the status", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{Zone: "europe-west1-b"})
+			cp.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apisgcp.ControlPlaneStatus{StoragePools: []string{"test-pool-a", "test-pool-b"}})}
+
+			gcpClientFactory.EXPECT().Compute(ctx, c, cp.Spec.SecretRef).Return(gcpComputeClient, nil)
+			gcpComputeClient.EXPECT().ListStoragePools(ctx, listOpts).Return([]*compute.StoragePool{
+				{Name: "test-pool-a", Zone: "https://www.googleapis.com/compute/v1/projects/foo/zones/europe-west1-b"},
+			}, nil)
+			genericActuator.EXPECT().Reconcile(ctx, logger, cp, nil).Return(false, nil)
+			gcpComputeClient.EXPECT().DeleteStoragePool(ctx, "europe-west1-b", "test-pool-a").Return(&googleapi.Error{
+				Code:   http.StatusBadRequest,
+				Errors: []googleapi.ErrorItem{{Reason: "resourceInUseByAnotherResource"}},
+			})
+			c.EXPECT().Status().Return(sw)
+			sw.EXPECT().Patch(ctx, cp, gomock.Any())
+
+			_, err := a.Reconcile(ctx, logger, cp, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cp.Status.ProviderStatus.Object).To(Equal(&apisgcpv1alpha1.ControlPlaneStatus{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "gcp.provider.extensions.gardener.cloud/v1alpha1",
+					Kind:       "ControlPlaneStatus",
+				},
+				StoragePools: []string{"test-pool-a"},
+			}))
+		})
+
+		Context("KMS encryption", func() {
+			var deploymentKey = client.ObjectKey{Namespace: namespace, Name: "kube-apiserver"}
+
+			BeforeEach(func() {
+				cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+					Zone: "europe-west1-b",
+					KMS:  &apisgcp.KMSConfig{KeyName: "projects/foo/locations/europe/keyRings/bar/cryptoKeys/baz"},
+				})
+			})
+
+			It("should switch the kube-apiserver to the encryption configuration with the KMS provider", func() {
+				gomock.InOrder(
+					genericActuator.EXPECT().Reconcile(ctx, logger, cp, nil).Return(false, nil),
+					c.EXPECT().Get(ctx, deploymentKey, gomock.AssignableToTypeOf(&appsv1.Deployment{})).
+						DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *appsv1.Deployment, _ ...client.GetOption) error {
+							obj.Spec.Template.Spec.Volumes = []corev1.Volume{{
+								Name:         "etcd-encryption-secret",
+								VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "kube-apiserver-etcd-encryption-configuration-abcd"}},
+							}}
+							return nil
+						}),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&appsv1.Deployment{}), client.RawPatch(types.MergePatchType, []byte("{}"))),
+				)
+
+				_, err := a.Reconcile(ctx, logger, cp, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should not patch the kube-apiserver if it already uses the encryption configuration with the KMS provider", func() {
+				gomock.InOrder(
+					genericActuator.EXPECT().Reconcile(ctx, logger, cp, nil).Return(false, nil),
+					c.EXPECT().Get(ctx, deploymentKey, gomock.AssignableToTypeOf(&appsv1.Deployment{})).
+						DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *appsv1.Deployment, _ ...client.GetOption) error {
+							obj.Spec.Template.Spec.Volumes = []corev1.Volume{{
+								Name:         "etcd-encryption-secret",
+								VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "kube-apiserver-etcd-encryption-configuration-gcp-kms"}},
+							}}
+							return nil
+						}),
+				)
+
+				_, err := a.Reconcile(ctx, logger, cp, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete all storage pools after the control plane", func() {
+			gomock.InOrder(
+				genericActuator.EXPECT().Delete(ctx, logger, cp, nil),
+				gcpClientFactory.EXPECT().Compute(ctx, c, cp.Spec.SecretRef).Return(gcpComputeClient, nil),
+				gcpComputeClient.EXPECT().ListStoragePools(ctx, listOpts).Return([]*compute.StoragePool{
+					{Name: "test-pool-a", Zone: "https://www.googleapis.com/compute/v1/projects/foo/zones/europe-west1-b"},
+				}, nil),
+				gcpComputeClient.EXPECT().DeleteStoragePool(ctx, "europe-west1-b", "test-pool-a"),
+			)
+
+			Expect(a.Delete(ctx, logger, cp, nil)).To(Succeed())
+		})
+
+		It("should not list the storage pools if none are configured or recorded in the status", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{Zone: "europe-west1-b"})
+
+			genericActuator.EXPECT().Delete(ctx, logger, cp, nil)
+
+			Expect(a.Delete(ctx, logger, cp, nil)).To(Succeed())
+		})
+	})
+})
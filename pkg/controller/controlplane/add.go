@@ -7,17 +7,23 @@ package controlplane
 import (
 	"context"
 	"sync/atomic"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane/genericactuator"
 	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-gcp/imagevector"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/instrumentation"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/resync"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal"
 )
 
@@ -38,6 +44,10 @@ type AddOptions struct {
 	ShootWebhookConfig *atomic.Value
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// ResyncPeriod is the period after which the resources are reconciled again even if they did not change.
+	ResyncPeriod time.Duration
+	// PodSecurity contains the security settings of the pods deployed into the shoot control planes.
+	PodSecurity *config.PodSecurity
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -46,15 +56,24 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 	genericActuator, err := genericactuator.NewActuator(mgr, gcp.Name,
 		secretConfigsFunc, shootAccessSecretsFunc, nil, nil,
 		configChart, controlPlaneChart, controlPlaneShootChart, controlPlaneShootCRDsChart, storageClassChart, nil,
-		NewValuesProvider(mgr), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
+		NewValuesProvider(mgr, opts.PodSecurity), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
 		imagevector.ImageVector(), internal.CloudProviderConfigName, opts.ShootWebhookConfig, opts.WebhookServerNamespace)
 	if err != nil {
 		return err
 	}
 
+	controllerOptions := resync.ControllerOptions(opts.Controller, resync.Args{
+		Reader:            mgr.GetClient(),
+		NewObjectFunc:     func() client.Object { return &extensionsv1alpha1.ControlPlane{} },
+		NewObjectListFunc: func() client.ObjectList { return &extensionsv1alpha1.ControlPlaneList{} },
+		Type:              gcp.Type,
+		ExtensionClass:    opts.ExtensionClass,
+		Period:            opts.ResyncPeriod,
+	})
+
 	return controlplane.Add(mgr, controlplane.AddArgs{
-		Actuator:          genericActuator,
-		ControllerOptions: opts.Controller,
+		Actuator:          instrumentation.NewControlPlaneActuator(NewActuator(mgr, genericActuator, gcpclient.New())),
+		ControllerOptions: controllerOptions,
 		Predicates:        controlplane.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              gcp.Type,
 		ExtensionClass:    opts.ExtensionClass,
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	// etcdEncryptionVolumeName is the name of the volume containing the encryption configuration of the
+	// kube-apiserver which is managed by Gardener.
+	etcdEncryptionVolumeName = "etcd-encryption-secret"
+	// etcdEncryptionDataKey is the key of the encryption configuration in the secret managed by Gardener.
+	etcdEncryptionDataKey = "encryption-configuration.yaml"
+	// etcdEncryptionConfigurationRole is the value of the role label of the secrets containing the encryption
+	// configuration of the kube-apiserver which are managed by Gardener.
+	etcdEncryptionConfigurationRole = "kube-apiserver-etcd-encryption-configuration"
+)
+
+var encryptionCodec runtime.Codec
+
+func init() {
+	encryptionScheme := runtime.NewScheme()
+	utilruntime.Must(apiserverconfigv1.AddToScheme(encryptionScheme))
+
+	var (
+		ser = json.NewSerializerWithOptions(json.DefaultMetaFactory, encryptionScheme, encryptionScheme, json.SerializerOptions{
+			Yaml:   true,
+			Pretty: false,
+			Strict: false,
+		})
+		versions = schema.GroupVersions([]schema.GroupVersion{
+			apiserverconfigv1.SchemeGroupVersion,
+		})
+	)
+
+	encryptionCodec = serializer.NewCodecFactory(encryptionScheme).CodecForVersions(ser, ser, versions, versions)
+}
+
+// getKMSEncryptionChartValues returns the values for the chart rendering the encryption configuration with the KMS
+// provider. It is derived from the current encryption configuration managed by Gardener, which is created before the
+// kube-apiserver is deployed, so that the KMS provider is used with the rotated encryption key right away.
+func getKMSEncryptionChartValues(ctx context.Context, c k8sclient.Client, namespace string) (map[string]interface{}, error) {
+	secret, err := currentEncryptionConfigurationSecret(ctx, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return map[string]interface{}{"enabled": false}, nil
+	}
+
+	config := &apiserverconfigv1.EncryptionConfiguration{}
+	if _, _, err := encryptionCodec.Decode(secret.Data[etcdEncryptionDataKey], nil, config); err != nil {
+		return nil, fmt.Errorf("failed decoding encryption configuration of secret %s: %w", secret.Name, err)
+	}
+	injectKMSProvider(config)
+
+	data, err := runtime.Encode(encryptionCodec, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"enabled":                 true,
+		"encryptionConfiguration": string(data),
+	}, nil
+}
+
+// currentEncryptionConfigurationSecret returns the secret containing the current encryption configuration managed by
+// Gardener. A new secret with a unique name is created whenever the configuration changes, e.g. when the encryption key
+// is rotated, hence the newest one is the current one. Older secrets are kept until they are not referenced anymore.
+func currentEncryptionConfigurationSecret(ctx context.Context, c k8sclient.Client, namespace string) (*corev1.Secret, error) {
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, k8sclient.InNamespace(namespace), k8sclient.MatchingLabels{v1beta1constants.LabelRole: etcdEncryptionConfigurationRole}); err != nil {
+		return nil, fmt.Errorf("failed listing encryption configuration secrets: %w", err)
+	}
+
+	var current *corev1.Secret
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
+		if current == nil || current.CreationTimestamp.Before(&secret.CreationTimestamp) {
+			current = secret
+		}
+	}
+	return current, nil
+}
+
+// ensureKubeAPIServerUsesKMSEncryption makes the kube-apiserver use the encryption configuration with the KMS provider
+// once it was rendered. The kube-apiserver is deployed before the control plane is reconciled, hence its deployment is
+// patched without changes, which lets the kube-apiserver webhook switch to the configuration.
+func ensureKubeAPIServerUsesKMSEncryption(ctx context.Context, c k8sclient.Client, namespace string) error {
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(ctx, k8sclient.ObjectKey{Namespace: namespace, Name: v1beta1constants.DeploymentNameKubeAPIServer}, deployment); err != nil {
+		return k8sclient.IgnoreNotFound(err)
+	}
+
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Name == etcdEncryptionVolumeName && volume.Secret != nil && volume.Secret.SecretName == gcp.KMSEncryptionSecretName {
+			return nil
+		}
+	}
+
+	return c.Patch(ctx, deployment, k8sclient.RawPatch(types.MergePatchType, []byte("{}")))
+}
+
+// injectKMSProvider adds the KMS provider in front of the providers of the given configuration, so that all resources
+// are encrypted with it while existing data can still be read with the original providers.
+func injectKMSProvider(config *apiserverconfigv1.EncryptionConfiguration) {
+	for i := range config.Resources {
+		providers := config.Resources[i].Providers
+		if len(providers) > 0 && providers[0].KMS != nil && providers[0].KMS.Name == gcp.KMSProviderName {
+			continue
+		}
+
+		// The GCP KMS plugin only implements the v1 KMS API.
+		config.Resources[i].Providers = append([]apiserverconfigv1.ProviderConfiguration{{
+			KMS: &apiserverconfigv1.KMSConfiguration{
+				APIVersion: "v1",
+				Name:       gcp.KMSProviderName,
+				Endpoint:   fmt.Sprintf("unix://%s/socket.sock", gcp.KMSPluginSocketDir),
+				Timeout:    &metav1.Duration{Duration: 3 * time.Second},
+			},
+		}}, providers...)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
@@ -28,6 +30,7 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -40,6 +43,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-gcp/charts"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal"
@@ -50,6 +54,9 @@ const (
 	caNameControlPlane                   = "ca-" + gcp.Name + "-controlplane"
 	cloudControllerManagerDeploymentName = "cloud-controller-manager"
 	cloudControllerManagerServerName     = "cloud-controller-manager-server"
+
+	// restrictedUserID is the non-root user and group the pods run with if the restricted pod security is enabled.
+	restrictedUserID = 65532
 )
 
 func secretConfigsFunc(namespace string) []extensionssecretsmanager.SecretConfigWithOptions {
@@ -130,6 +137,10 @@ var (
 					{Type: &appsv1.Deployment{}, Name: gcp.CSIControllerName},
 					{Type: &corev1.ConfigMap{}, Name: gcp.CSIControllerConfigName},
 					{Type: &autoscalingv1.VerticalPodAutoscaler{}, Name: gcp.CSIControllerName + "-vpa"},
+					{Type: &corev1.Service{}, Name: gcp.CSIControllerName},
+					{Type: &corev1.ConfigMap{}, Name: gcp.CSIControllerName + "-dashboard"},
+					{Type: &monitoringv1.ServiceMonitor{}, Name: "shoot-" + gcp.CSIControllerName},
+					{Type: &monitoringv1.PrometheusRule{}, Name: "shoot-" + gcp.CSIControllerName},
 					// csi-snapshot-controller
 					{Type: &appsv1.Deployment{}, Name: gcp.CSISnapshotControllerName},
 					{Type: &autoscalingv1.VerticalPodAutoscaler{}, Name: gcp.CSISnapshotControllerName + "-vpa"},
@@ -146,6 +157,11 @@ var (
 					{Type: &corev1.ServiceAccount{}, Name: "glbc"},
 				},
 			},
+			{
+				// The name of the secret changes with the encryption configuration managed by Gardener, outdated
+				// secrets are garbage collected once the kube-apiserver does not reference them anymore.
+				Name: gcp.KMSEncryptionName,
+			},
 		},
 	}
 
@@ -253,18 +269,20 @@ var (
 )
 
 // NewValuesProvider creates a new ValuesProvider for the generic actuator.
-func NewValuesProvider(mgr manager.Manager) genericactuator.ValuesProvider {
+func NewValuesProvider(mgr manager.Manager, podSecurity *config.PodSecurity) genericactuator.ValuesProvider {
 	return &valuesProvider{
-		client:  mgr.GetClient(),
-		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		client:      mgr.GetClient(),
+		decoder:     serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		podSecurity: podSecurity,
 	}
 }
 
 // valuesProvider is a ValuesProvider that provides GCP-specific values for the 2 charts applied by the generic actuator.
 type valuesProvider struct {
 	genericactuator.NoopValuesProvider
-	client  k8sclient.Client
-	decoder runtime.Decoder
+	client      k8sclient.Client
+	decoder     runtime.Decoder
+	podSecurity *config.PodSecurity
 }
 
 // GetConfigChartValues returns the values for the config chart applied by the generic actuator.
@@ -331,6 +349,18 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 		return nil, err
 	}
 
+	if !isCSISnapshotsEnabled(cpConfig) {
+		if err := cleanupCSISnapshotController(ctx, vp.client, cp.Namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	if !isIngressGCEEnabled(cpConfig, cluster) {
+		if err := cleanupIngressGCE(ctx, vp.client, cp.Namespace); err != nil {
+			return nil, err
+		}
+	}
+
 	// TODO(rfranzke): Delete this after August 2024.
 	gep19Monitoring := vp.client.Get(ctx, k8sclient.ObjectKey{Name: "prometheus-shoot", Namespace: cp.Namespace}, &appsv1.StatefulSet{}) == nil
 	if gep19Monitoring {
@@ -339,13 +369,26 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 		}
 	}
 
-	return vp.getControlPlaneChartValues(cpConfig, cp, cluster, secretsReader, credentialsConfig, checksums, scaledDown, gep19Monitoring)
+	values, err := vp.getControlPlaneChartValues(cpConfig, cp, cluster, secretsReader, credentialsConfig, checksums, scaledDown, gep19Monitoring)
+	if err != nil {
+		return nil, err
+	}
+
+	kmsEncryption := map[string]interface{}{"enabled": false}
+	if cpConfig.KMS != nil {
+		if kmsEncryption, err = getKMSEncryptionChartValues(ctx, vp.client, cp.Namespace); err != nil {
+			return nil, err
+		}
+	}
+	values[gcp.KMSEncryptionName] = kmsEncryption
+
+	return values, nil
 }
 
 // GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
 func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	_ context.Context,
-	_ *extensionsv1alpha1.ControlPlane,
+	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	_ secretsmanager.Reader,
 	_ map[string]string,
@@ -353,14 +396,41 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	map[string]interface{},
 	error,
 ) {
+	cpConfig := &apisgcp.ControlPlaneConfig{}
+	if cp.Spec.ProviderConfig != nil {
+		if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", k8sclient.ObjectKeyFromObject(cp), err)
+		}
+	}
+
+	ccm := map[string]interface{}{"enabled": true}
+	if cpConfig.LoadBalancer != nil {
+		loadBalancer := map[string]interface{}{
+			"type":         ptr.Deref(cpConfig.LoadBalancer.Type, gcp.LoadBalancerTypeExternal),
+			"globalAccess": ptr.Deref(cpConfig.LoadBalancer.GlobalAccess, false),
+		}
+		if cpConfig.LoadBalancer.NetworkTier != nil {
+			loadBalancer["networkTier"] = *cpConfig.LoadBalancer.NetworkTier
+		}
+		if len(cpConfig.LoadBalancer.SourceRanges) > 0 {
+			loadBalancer["sourceRanges"] = strings.Join(cpConfig.LoadBalancer.SourceRanges, ",")
+		}
+		ccm["loadBalancer"] = loadBalancer
+	}
+
+	csiNode := map[string]interface{}{
+		"enabled":           true,
+		"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
+	}
+	if cpConfig.CSIDriverNode != nil && !ptr.Deref(cpConfig.CSIDriverNode.Enabled, true) {
+		csiNode["nodePluginEnabled"] = false
+	}
+
 	return map[string]interface{}{
-		gcp.CloudControllerManagerName: map[string]interface{}{"enabled": true},
-		gcp.CSINodeName: map[string]interface{}{
-			"enabled":           true,
-			"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
-		},
+		gcp.CloudControllerManagerName: ccm,
+		gcp.CSINodeName:                csiNode,
 		"default-http-backend": map[string]interface{}{
-			"enabled": isDualstackEnabled(cluster.Shoot.Spec.Networking),
+			"enabled": isIngressGCEEnabled(cpConfig, cluster),
 		},
 	}, nil
 }
@@ -374,16 +444,25 @@ func getConfigChartValues(
 ) (map[string]interface{}, error) {
 	// Determine network names
 	networkName, subNetworkName, subNetworkNameNodes := getNetworkNames(infraStatus, cp)
+	if cpConfig.LoadBalancer != nil && cpConfig.LoadBalancer.Subnet != nil {
+		subNetworkName = *cpConfig.LoadBalancer.Subnet
+	}
 
 	// Collect config chart values
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"projectID":           credentialsConfig.ProjectID,
 		"networkName":         networkName,
 		"subNetworkName":      subNetworkName,
 		"subNetworkNameNodes": subNetworkNameNodes,
 		"zone":                cpConfig.Zone,
 		"nodeTags":            cp.Namespace,
-	}, nil
+	}
+
+	if cpConfig.LoadBalancer != nil && len(cpConfig.LoadBalancer.FirewallTargetTags) > 0 {
+		values["additionalNodeTags"] = cpConfig.LoadBalancer.FirewallTargetTags
+	}
+
+	return values, nil
 }
 
 func shouldUseWorkloadIdentity(credentialsConfig *gcp.CredentialsConfig) bool {
@@ -414,19 +493,76 @@ func (vp *valuesProvider) getControlPlaneChartValues(
 		return nil, err
 	}
 
+	ingressGCE := map[string]interface{}{
+		"enabled":  isIngressGCEEnabled(cpConfig, cluster),
+		"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+	}
+
+	for _, values := range []map[string]interface{}{ccm, csi, ingressGCE} {
+		if err := setSecurityContexts(values, vp.podSecurity); err != nil {
+			return nil, err
+		}
+	}
+
 	return map[string]interface{}{
 		"global": map[string]interface{}{
 			"genericTokenKubeconfigSecretName": extensionscontroller.GenericTokenKubeconfigSecretNameFromCluster(cluster),
 		},
 		gcp.CloudControllerManagerName: ccm,
 		gcp.CSIControllerName:          csi,
-		gcp.IngressGCEName: map[string]interface{}{
-			"enabled":  isDualstackEnabled(cluster.Shoot.Spec.Networking),
-			"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
-		},
+		gcp.IngressGCEName:             ingressGCE,
 	}, nil
 }
 
+// setSecurityContexts sets the pod and container security contexts derived from the given pod security configuration
+// in values. If no pod security is configured, the defaults of the charts are used.
+func setSecurityContexts(values map[string]interface{}, podSecurity *config.PodSecurity) error {
+	if podSecurity == nil {
+		return nil
+	}
+
+	podSecurityContext := &corev1.PodSecurityContext{
+		SeccompProfile:  podSecurity.SeccompProfile,
+		AppArmorProfile: podSecurity.AppArmorProfile,
+	}
+	containerSecurityContext := &corev1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.To(false),
+	}
+
+	if podSecurity.Restricted {
+		podSecurityContext.RunAsNonRoot = ptr.To(true)
+		podSecurityContext.RunAsUser = ptr.To[int64](restrictedUserID)
+		podSecurityContext.RunAsGroup = ptr.To[int64](restrictedUserID)
+		podSecurityContext.FSGroup = ptr.To[int64](restrictedUserID)
+		if podSecurityContext.SeccompProfile == nil {
+			podSecurityContext.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+		}
+		containerSecurityContext.Capabilities = &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}}
+	}
+
+	podSecurityContextValues, err := runtime.DefaultUnstructuredConverter.ToUnstructured(podSecurityContext)
+	if err != nil {
+		return err
+	}
+	containerSecurityContextValues, err := runtime.DefaultUnstructuredConverter.ToUnstructured(containerSecurityContext)
+	if err != nil {
+		return err
+	}
+
+	values["podSecurityContext"] = podSecurityContextValues
+	values["containerSecurityContext"] = containerSecurityContextValues
+	return nil
+}
+
+// isIngressGCEEnabled checks whether the ingress-gce controller must be deployed. It is required for dual-stack shoots
+// and can be enabled explicitly for container-native load balancing.
+func isIngressGCEEnabled(cpConfig *apisgcp.ControlPlaneConfig, cluster *extensionscontroller.Cluster) bool {
+	if cpConfig.IngressGCE != nil && ptr.Deref(cpConfig.IngressGCE.Enabled, false) {
+		return true
+	}
+	return isDualstackEnabled(cluster.Shoot.Spec.Networking)
+}
+
 func isDualstackEnabled(networking *gardencorev1beta1.Networking) bool {
 	if networking != nil {
 		return !gardencorev1beta1.IsIPv4SingleStack(networking.IPFamilies)
@@ -476,6 +612,12 @@ func (vp *valuesProvider) getCCMChartValues(
 
 	if cpConfig.CloudControllerManager != nil {
 		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+		if len(cpConfig.CloudControllerManager.Flags) > 0 {
+			values["flags"] = cpConfig.CloudControllerManager.Flags
+		}
+		if err := setReplicasAndResources(values, cluster, scaledDown, cpConfig.CloudControllerManager.Replicas, cpConfig.CloudControllerManager.Resources, "resources"); err != nil {
+			return nil, err
+		}
 	}
 
 	overlayEnabled, err := vp.isOverlayEnabled(cluster.Shoot.Spec.Networking)
@@ -489,6 +631,17 @@ func (vp *valuesProvider) getCCMChartValues(
 		values["allocatorType"] = "CloudAllocator"
 	}
 
+	if cpConfig.CloudControllerManager != nil && cpConfig.CloudControllerManager.NodeIPAMMode != nil {
+		switch *cpConfig.CloudControllerManager.NodeIPAMMode {
+		case gcp.NodeIPAMModeRoutes:
+			values["configureCloudRoutes"] = true
+			values["allocatorType"] = "RangeAllocator"
+		case gcp.NodeIPAMModeAliasIP:
+			values["configureCloudRoutes"] = false
+			values["allocatorType"] = "CloudAllocator"
+		}
+	}
+
 	if cluster.Shoot.Spec.Kubernetes.KubeControllerManager != nil && cluster.Shoot.Spec.Kubernetes.KubeControllerManager.NodeCIDRMaskSize != nil {
 		if len(cluster.Shoot.Spec.Networking.IPFamilies) == 1 && cluster.Shoot.Spec.Networking.IPFamilies[0] == v1beta1.IPFamilyIPv4 {
 			values["nodeCIDRMaskSizeIPv4"] = *cluster.Shoot.Spec.Kubernetes.KubeControllerManager.NodeCIDRMaskSize
@@ -548,12 +701,119 @@ func getCSIControllerChartValues(
 		}
 	}
 
+	if cpConfig.Storage != nil && cpConfig.Storage.Topology != nil && ptr.Deref(cpConfig.Storage.Topology.StrictTopology, false) {
+		getOrCreateMap(values, "csiProvisioner")["strictTopology"] = true
+	}
+
+	if cpConfig.CSIDriverController != nil {
+		for key, sidecar := range map[string]*apisgcp.CSISidecarConfig{
+			"csiProvisioner": cpConfig.CSIDriverController.Provisioner,
+			"csiAttacher":    cpConfig.CSIDriverController.Attacher,
+			"csiResizer":     cpConfig.CSIDriverController.Resizer,
+		} {
+			if sidecar == nil {
+				continue
+			}
+
+			sidecarValues := getOrCreateMap(values, key)
+			if sidecar.Timeout != nil {
+				sidecarValues["timeout"] = sidecar.Timeout.Duration.String()
+			}
+			if sidecar.Workers != nil {
+				sidecarValues["workers"] = *sidecar.Workers
+			}
+		}
+
+		for key, sidecar := range map[string]*apisgcp.CSISidecarConfig{
+			"provisioner": cpConfig.CSIDriverController.Provisioner,
+			"attacher":    cpConfig.CSIDriverController.Attacher,
+			"resizer":     cpConfig.CSIDriverController.Resizer,
+		} {
+			if sidecar == nil || sidecar.Resources == nil {
+				continue
+			}
+
+			resources, err := runtime.DefaultUnstructuredConverter.ToUnstructured(sidecar.Resources)
+			if err != nil {
+				return nil, err
+			}
+			getOrCreateMap(values, "resources")[key] = resources
+		}
+
+		if err := setReplicasAndResources(values, cluster, scaledDown, cpConfig.CSIDriverController.Replicas, cpConfig.CSIDriverController.Resources, "resources", "driver"); err != nil {
+			return nil, err
+		}
+
+		if ptr.Deref(cpConfig.CSIDriverController.MetricsEnabled, false) {
+			values["metricsEnabled"] = true
+		}
+
+		var disabledSidecars []string
+		if !ptr.Deref(cpConfig.CSIDriverController.ResizerEnabled, true) {
+			disabledSidecars = append(disabledSidecars, "resizer")
+		}
+		if !isCSISnapshotsEnabled(cpConfig) {
+			disabledSidecars = append(disabledSidecars, "snapshotter")
+			getOrCreateMap(values, "csiSnapshotController")["enabled"] = false
+		}
+		if len(disabledSidecars) > 0 {
+			values["disabledSidecars"] = disabledSidecars
+		}
+	}
+
 	return values, nil
 }
 
+// isCSISnapshotsEnabled returns whether the csi-snapshotter sidecar and the csi-snapshot-controller are deployed.
+func isCSISnapshotsEnabled(cpConfig *apisgcp.ControlPlaneConfig) bool {
+	return cpConfig.CSIDriverController == nil || ptr.Deref(cpConfig.CSIDriverController.SnapshotsEnabled, true)
+}
+
+// setReplicasAndResources overwrites the replicas of a control plane component unless it is scaled down, and stores
+// the given resource requirements under the given path in values.
+func setReplicasAndResources(
+	values map[string]interface{},
+	cluster *extensionscontroller.Cluster,
+	scaledDown bool,
+	replicas *int32,
+	resources *corev1.ResourceRequirements,
+	resourcesPath ...string,
+) error {
+	if replicas != nil && extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1) > 0 {
+		values["replicas"] = int(*replicas)
+		values["replicasOverwrite"] = true
+	}
+
+	if resources == nil {
+		return nil
+	}
+
+	resourcesValues, err := runtime.DefaultUnstructuredConverter.ToUnstructured(resources)
+	if err != nil {
+		return err
+	}
+
+	m := values
+	for _, key := range resourcesPath[:len(resourcesPath)-1] {
+		m = getOrCreateMap(m, key)
+	}
+	m[resourcesPath[len(resourcesPath)-1]] = resourcesValues
+	return nil
+}
+
+// getOrCreateMap returns the map stored under the given key in values. If there is none, an empty map is added.
+func getOrCreateMap(values map[string]interface{}, key string) map[string]interface{} {
+	m, ok := values[key].(map[string]interface{})
+	if !ok {
+		m = map[string]interface{}{}
+		values[key] = m
+	}
+	return m
+}
+
 // getStorageClassChartValues collects and returns the shoot storage-class chart values.
 func (vp *valuesProvider) GetStorageClassesChartValues(
-	_ context.Context,
+	ctx context.Context,
 	cp *extensionsv1alpha1.ControlPlane,
 	_ *extensionscontroller.Cluster,
 ) (map[string]interface{}, error) {
@@ -573,10 +833,121 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 		managedDefaultVolumeSnapshotClass = ptr.Deref(cpConfig.Storage.ManagedDefaultVolumeSnapshotClass, true)
 	}
 
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"managedDefaultStorageClass":        managedDefaultStorageClass,
 		"managedDefaultVolumeSnapshotClass": managedDefaultVolumeSnapshotClass,
-	}, nil
+	}
+
+	if cpConfig.Storage != nil && cpConfig.Storage.AllowVolumeExpansion != nil {
+		values["allowVolumeExpansion"] = *cpConfig.Storage.AllowVolumeExpansion
+	}
+
+	if cpConfig.Storage != nil && cpConfig.Storage.Topology != nil {
+		if cpConfig.Storage.Topology.VolumeBindingMode != nil {
+			values["volumeBindingMode"] = *cpConfig.Storage.Topology.VolumeBindingMode
+		}
+		if len(cpConfig.Storage.Topology.AllowedZones) > 0 {
+			values["allowedZones"] = cpConfig.Storage.Topology.AllowedZones
+		}
+	}
+
+	if cpConfig.Storage != nil && cpConfig.Storage.VolumeSnapshotClass != nil {
+		values["volumeSnapshotClass"] = getVolumeSnapshotClassValues(cpConfig.Storage.VolumeSnapshotClass)
+	}
+
+	// Volumes of the StorageClasses could not be mounted without the node plugin of the CSI driver.
+	if cpConfig.CSIDriverNode != nil && !ptr.Deref(cpConfig.CSIDriverNode.Enabled, true) {
+		values["storageClassesEnabled"] = false
+	}
+
+	if cpConfig.Storage != nil && len(cpConfig.Storage.StorageClasses) > 0 {
+		storagePools, err := vp.getStoragePoolResourceNames(ctx, cp, cpConfig.Storage.StoragePools)
+		if err != nil {
+			return nil, err
+		}
+		values["storageClasses"] = getStorageClassesValues(cpConfig.Storage.StorageClasses, storagePools)
+	}
+
+	return values, nil
+}
+
+// getStoragePoolResourceNames returns the resource names of the given storage pools in GCP mapped to their names in the
+// ControlPlaneConfig.
+func (vp *valuesProvider) getStoragePoolResourceNames(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, storagePools []apisgcp.StoragePool) (map[string]string, error) {
+	if len(storagePools) == 0 {
+		return nil, nil
+	}
+
+	credentialsConfig, err := gcp.GetCredentialsConfigFromSecretReference(ctx, vp.client, cp.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(storagePools))
+	for _, pool := range storagePools {
+		names[pool.Name] = fmt.Sprintf("projects/%s/zones/%s/storagePools/%s", credentialsConfig.ProjectID, pool.Zone, storagePoolName(cp.Namespace, pool.Name))
+	}
+	return names, nil
+}
+
+// getStorageClassesValues translates the StorageClass settings into the parameters of the pd csi driver.
+func getStorageClassesValues(storageClasses []apisgcp.StorageClass, storagePools map[string]string) []map[string]interface{} {
+	values := make([]map[string]interface{}, 0, len(storageClasses))
+
+	for _, sc := range storageClasses {
+		parameters := map[string]interface{}{
+			"type": sc.Type,
+		}
+		if sc.ReplicationType != nil {
+			parameters["replication-type"] = *sc.ReplicationType
+		}
+		if sc.ProvisionedIops != nil {
+			parameters["provisioned-iops-on-create"] = strconv.FormatInt(*sc.ProvisionedIops, 10)
+		}
+		if sc.ProvisionedThroughput != nil {
+			parameters["provisioned-throughput-on-create"] = fmt.Sprintf("%dMi", *sc.ProvisionedThroughput)
+		}
+		if len(sc.StoragePools) > 0 {
+			pools := make([]string, 0, len(sc.StoragePools))
+			for _, name := range sc.StoragePools {
+				pools = append(pools, storagePools[name])
+			}
+			parameters["storage-pools"] = strings.Join(pools, ",")
+		}
+
+		values = append(values, map[string]interface{}{
+			"name":       sc.Name,
+			"default":    ptr.Deref(sc.Default, false),
+			"parameters": parameters,
+		})
+	}
+
+	return values
+}
+
+// getVolumeSnapshotClassValues translates the VolumeSnapshotClass settings into the parameters of the pd csi driver.
+func getVolumeSnapshotClassValues(config *apisgcp.VolumeSnapshotClass) map[string]interface{} {
+	parameters := map[string]interface{}{}
+
+	if config.SnapshotType != nil {
+		parameters["snapshot-type"] = *config.SnapshotType
+	}
+	if config.StorageLocation != nil {
+		parameters["storage-locations"] = *config.StorageLocation
+	}
+	if len(config.Labels) > 0 {
+		labels := make([]string, 0, len(config.Labels))
+		for k, v := range config.Labels {
+			labels = append(labels, k+"="+v)
+		}
+		slices.Sort(labels)
+		parameters["labels"] = strings.Join(labels, ",")
+	}
+
+	return map[string]interface{}{
+		"deletionPolicy": ptr.Deref(config.DeletionPolicy, gcp.VolumeSnapshotDeletionPolicyDelete),
+		"parameters":     parameters,
+	}
 }
 
 // getNetworkNames determines the network and subnetwork names from the given infrastructure status and controlplane.
@@ -625,6 +996,31 @@ func (vp *valuesProvider) isOverlayEnabled(network *gardencorev1beta1.Networking
 	return true, nil
 }
 
+// cleanupCSISnapshotController deletes the csi-snapshot-controller from the control plane as it is not removed by the
+// chart applier when it is disabled.
+func cleanupCSISnapshotController(ctx context.Context, client k8sclient.Client, namespace string) error {
+	if err := kutil.DeleteObjects(ctx, client,
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: gcp.CSISnapshotControllerName, Namespace: namespace}},
+		&autoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: gcp.CSISnapshotControllerName + "-vpa", Namespace: namespace}},
+		&policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: gcp.CSISnapshotControllerName, Namespace: namespace}},
+	); err != nil {
+		return fmt.Errorf("failed to delete csi-snapshot-controller: %w", err)
+	}
+	return nil
+}
+
+// cleanupIngressGCE deletes the ingress-gce from the control plane as it is not removed by the chart applier when it is
+// disabled.
+func cleanupIngressGCE(ctx context.Context, client k8sclient.Client, namespace string) error {
+	if err := kutil.DeleteObjects(ctx, client,
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: gcp.IngressGCEName, Namespace: namespace}},
+		&autoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: gcp.IngressGCEName + "-vpa", Namespace: namespace}},
+	); err != nil {
+		return fmt.Errorf("failed to delete ingress-gce: %w", err)
+	}
+	return nil
+}
+
 func cleanupSeedLegacyCSISnapshotValidation(
 	ctx context.Context,
 	client k8sclient.Client,
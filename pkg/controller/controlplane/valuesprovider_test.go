@@ -7,12 +7,15 @@ package controlplane
 import (
 	"context"
 	"encoding/json"
+	"path/filepath"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane/genericactuator"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/utils"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
@@ -20,17 +23,24 @@ import (
 	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	autoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener-extension-provider-gcp/charts"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal"
@@ -139,7 +149,7 @@ var _ = Describe("ValuesProvider", func() {
 		mgr = mockmanager.NewMockManager(ctrl)
 		mgr.EXPECT().GetClient().Return(c)
 		mgr.EXPECT().GetScheme().Return(scheme)
-		vp = NewValuesProvider(mgr)
+		vp = NewValuesProvider(mgr, nil)
 
 		fakeClient = fakeclient.NewClientBuilder().Build()
 		fakeSecretsManager = fakesecretsmanager.New(fakeClient, namespace)
@@ -194,6 +204,37 @@ var _ = Describe("ValuesProvider", func() {
 				"nodeTags":            namespace,
 			}))
 		})
+
+		It("should use the configured subnet for internal load balancers", func() {
+			c.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: zone,
+				LoadBalancer: &apisgcp.LoadBalancerConfig{
+					Subnet: ptr.To("subnet-lb"),
+				},
+			})
+
+			values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("subNetworkName", "subnet-lb"))
+		})
+
+		It("should add the firewall target tags to the node tags", func() {
+			c.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: zone,
+				LoadBalancer: &apisgcp.LoadBalancerConfig{
+					FirewallTargetTags: []string{"lb-backends"},
+				},
+			})
+
+			values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(And(
+				HaveKeyWithValue("nodeTags", namespace),
+				HaveKeyWithValue("additionalNodeTags", []string{"lb-backends"}),
+			))
+		})
 	})
 
 	Describe("#GetControlPlaneChartValues", func() {
@@ -240,6 +281,9 @@ var _ = Describe("ValuesProvider", func() {
 			c.EXPECT().Delete(context.TODO(), &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: gcp.CSISnapshotValidationName, Namespace: namespace}})
 			c.EXPECT().Delete(context.TODO(), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "csi-driver-controller-observability-config", Namespace: namespace}})
 			c.EXPECT().Get(context.TODO(), client.ObjectKey{Name: "prometheus-shoot", Namespace: cp.Namespace}, gomock.AssignableToTypeOf(&appsv1.StatefulSet{})).Return(apierrors.NewNotFound(schema.GroupResource{}, ""))
+			// ingress-gce is only cleaned up if it is not enabled
+			c.EXPECT().Delete(context.TODO(), &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: gcp.IngressGCEName, Namespace: namespace}}).AnyTimes()
+			c.EXPECT().Delete(context.TODO(), &autoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: gcp.IngressGCEName + "-vpa", Namespace: namespace}}).AnyTimes()
 		})
 
 		It("should return correct control plane chart values", func() {
@@ -271,9 +315,97 @@ var _ = Describe("ValuesProvider", func() {
 					"enabled":  isDualstackEnabled(cluster.Shoot.Spec.Networking),
 					"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, false, 1),
 				},
+				gcp.KMSEncryptionName: map[string]interface{}{
+					"enabled": false,
+				},
 			}))
 		})
 
+		Context("KMS encryption", func() {
+			encryptionConfiguration := func(key string) []byte {
+				return []byte(`apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+- resources:
+  - secrets
+  providers:
+  - aescbc:
+      keys:
+      - name: key1
+        secret: ` + key + `
+  - identity: {}
+`)
+			}
+
+			BeforeEach(func() {
+				cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+					Zone: "europe-west1a",
+					KMS:  &apisgcp.KMSConfig{KeyName: "projects/foo/locations/europe/keyRings/bar/cryptoKeys/baz"},
+				})
+			})
+
+			It("should render the encryption configuration with the KMS provider", func() {
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.SecretList{}), client.InNamespace(namespace), client.MatchingLabels{"role": "kube-apiserver-etcd-encryption-configuration"}).
+					DoAndReturn(func(_ context.Context, list *corev1.SecretList, _ ...client.ListOption) error {
+						list.Items = []corev1.Secret{{
+							ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-configuration-abcd", Namespace: namespace},
+							Data:       map[string][]byte{"encryption-configuration.yaml": encryptionConfiguration("c2VjcmV0")},
+						}}
+						return nil
+					})
+
+				values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values[gcp.KMSEncryptionName]).To(MatchAllKeys(Keys{
+					"enabled": BeTrue(),
+					"encryptionConfiguration": And(
+						ContainSubstring("apiVersion: v1"),
+						ContainSubstring("name: gcp-kms"),
+						ContainSubstring("endpoint: unix:///var/run/kmsplugin/socket.sock"),
+						ContainSubstring("secret: c2VjcmV0"),
+					),
+				}))
+			})
+
+			It("should render the encryption configuration with the rotated encryption key", func() {
+				now := time.Now()
+
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.SecretList{}), client.InNamespace(namespace), client.MatchingLabels{"role": "kube-apiserver-etcd-encryption-configuration"}).
+					DoAndReturn(func(_ context.Context, list *corev1.SecretList, _ ...client.ListOption) error {
+						list.Items = []corev1.Secret{
+							{
+								ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-configuration-efgh", Namespace: namespace, CreationTimestamp: metav1.NewTime(now)},
+								Data:       map[string][]byte{"encryption-configuration.yaml": encryptionConfiguration("cm90YXRlZA==")},
+							},
+							{
+								ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-configuration-abcd", Namespace: namespace, CreationTimestamp: metav1.NewTime(now.Add(-time.Hour))},
+								Data:       map[string][]byte{"encryption-configuration.yaml": encryptionConfiguration("c2VjcmV0")},
+							},
+						}
+						return nil
+					})
+
+				values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values[gcp.KMSEncryptionName]).To(MatchAllKeys(Keys{
+					"enabled": BeTrue(),
+					"encryptionConfiguration": And(
+						ContainSubstring("name: gcp-kms"),
+						ContainSubstring("secret: cm90YXRlZA=="),
+						Not(ContainSubstring("secret: c2VjcmV0")),
+					),
+				}))
+			})
+
+			It("should not render the encryption configuration before it was created by Gardener", func() {
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.SecretList{}), client.InNamespace(namespace), client.MatchingLabels{"role": "kube-apiserver-etcd-encryption-configuration"})
+
+				values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values[gcp.KMSEncryptionName]).To(Equal(map[string]interface{}{"enabled": false}))
+			})
+		})
+
 		It("should return correct control plane chart values for clusters without overlay", func() {
 			shootWithoutOverlay := cluster.Shoot.DeepCopy()
 			shootWithoutOverlay.Spec.Networking.Type = ptr.To("calico")
@@ -312,6 +444,242 @@ var _ = Describe("ValuesProvider", func() {
 			})))
 		})
 
+		It("should return correct control plane chart values when configuring additional CCM flags", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				CloudControllerManager: &apisgcp.CloudControllerManagerConfig{
+					Flags: map[string]string{
+						"node-monitor-period": "10s",
+					},
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CloudControllerManagerName]).To(HaveKeyWithValue("flags", map[string]string{
+				"node-monitor-period": "10s",
+			}))
+		})
+
+		It("should configure the cloud-controller-manager for alias IP based pod networking", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				CloudControllerManager: &apisgcp.CloudControllerManagerConfig{
+					NodeIPAMMode: ptr.To("AliasIP"),
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CloudControllerManagerName]).To(And(
+				HaveKeyWithValue("configureCloudRoutes", false),
+				HaveKeyWithValue("allocatorType", "CloudAllocator"),
+			))
+		})
+
+		It("should configure the cloud-controller-manager for route based pod networking", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				CloudControllerManager: &apisgcp.CloudControllerManagerConfig{
+					NodeIPAMMode: ptr.To("Routes"),
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CloudControllerManagerName]).To(And(
+				HaveKeyWithValue("configureCloudRoutes", true),
+				HaveKeyWithValue("allocatorType", "RangeAllocator"),
+			))
+		})
+
+		It("should return correct control plane chart values when configuring the csi sidecars", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				CSIDriverController: &apisgcp.CSIDriverControllerConfig{
+					Attacher: &apisgcp.CSISidecarConfig{
+						Timeout: &metav1.Duration{Duration: 2 * time.Minute},
+						Workers: ptr.To[int32](50),
+					},
+					Resizer: &apisgcp.CSISidecarConfig{
+						Workers: ptr.To[int32](20),
+					},
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CSIControllerName]).To(And(
+				HaveKeyWithValue("csiAttacher", map[string]interface{}{
+					"timeout": "2m0s",
+					"workers": int32(50),
+				}),
+				HaveKeyWithValue("csiResizer", map[string]interface{}{
+					"workers": int32(20),
+				}),
+				Not(HaveKey("csiProvisioner")),
+			))
+		})
+
+		It("should enable the metrics of the csi-driver-controller", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				CSIDriverController: &apisgcp.CSIDriverControllerConfig{
+					MetricsEnabled: ptr.To(true),
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CSIControllerName]).To(HaveKeyWithValue("metricsEnabled", true))
+		})
+
+		It("should disable the csi-resizer and the snapshot components of the csi-driver-controller", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				CSIDriverController: &apisgcp.CSIDriverControllerConfig{
+					SnapshotsEnabled: ptr.To(false),
+					ResizerEnabled:   ptr.To(false),
+				},
+			})
+
+			c.EXPECT().Delete(context.TODO(), &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: gcp.CSISnapshotControllerName, Namespace: namespace}})
+			c.EXPECT().Delete(context.TODO(), &autoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: gcp.CSISnapshotControllerName + "-vpa", Namespace: namespace}})
+			c.EXPECT().Delete(context.TODO(), &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: gcp.CSISnapshotControllerName, Namespace: namespace}})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CSIControllerName]).To(And(
+				HaveKeyWithValue("disabledSidecars", []string{"resizer", "snapshotter"}),
+				HaveKeyWithValue("csiSnapshotController", map[string]interface{}{
+					"enabled":  false,
+					"replicas": 1,
+				}),
+			))
+		})
+
+		It("should deploy ingress-gce when it is enabled", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				IngressGCE: &apisgcp.IngressGCEConfig{
+					Enabled: ptr.To(true),
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(gcp.IngressGCEName, map[string]interface{}{
+				"enabled":  true,
+				"replicas": 1,
+			}))
+		})
+
+		It("should set the restricted security contexts for the control plane components", func() {
+			vp.(*valuesProvider).podSecurity = &config.PodSecurity{
+				Restricted:      true,
+				AppArmorProfile: &corev1.AppArmorProfile{Type: corev1.AppArmorProfileTypeRuntimeDefault},
+			}
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, name := range []string{gcp.CloudControllerManagerName, gcp.CSIControllerName, gcp.IngressGCEName} {
+				Expect(values[name]).To(And(
+					HaveKeyWithValue("podSecurityContext", map[string]interface{}{
+						"runAsUser":       int64(65532),
+						"runAsGroup":      int64(65532),
+						"runAsNonRoot":    true,
+						"fsGroup":         int64(65532),
+						"seccompProfile":  map[string]interface{}{"type": "RuntimeDefault"},
+						"appArmorProfile": map[string]interface{}{"type": "RuntimeDefault"},
+					}),
+					HaveKeyWithValue("containerSecurityContext", map[string]interface{}{
+						"allowPrivilegeEscalation": false,
+						"capabilities":             map[string]interface{}{"drop": []interface{}{"ALL"}},
+					}),
+				), name)
+			}
+		})
+
+		It("should overwrite the replicas and resources of the cloud-controller-manager and csi-driver-controller", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				CloudControllerManager: &apisgcp.CloudControllerManagerConfig{
+					Replicas: ptr.To[int32](3),
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("500Mi")},
+					},
+				},
+				CSIDriverController: &apisgcp.CSIDriverControllerConfig{
+					Replicas: ptr.To[int32](2),
+					Resources: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+					},
+					Resizer: &apisgcp.CSISidecarConfig{
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+						},
+					},
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CloudControllerManagerName]).To(And(
+				HaveKeyWithValue("replicas", 3),
+				HaveKeyWithValue("replicasOverwrite", true),
+				HaveKeyWithValue("resources", map[string]interface{}{
+					"requests": map[string]interface{}{"memory": "500Mi"},
+				}),
+			))
+			Expect(values[gcp.CSIControllerName]).To(And(
+				HaveKeyWithValue("replicas", 2),
+				HaveKeyWithValue("replicasOverwrite", true),
+				HaveKeyWithValue("resources", map[string]interface{}{
+					"driver": map[string]interface{}{
+						"limits": map[string]interface{}{"memory": "1Gi"},
+					},
+					"resizer": map[string]interface{}{
+						"requests": map[string]interface{}{"cpu": "50m"},
+					},
+				}),
+			))
+		})
+
+		It("should not overwrite the replicas if the control plane is scaled down", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				CloudControllerManager: &apisgcp.CloudControllerManagerConfig{
+					Replicas: ptr.To[int32](3),
+				},
+			})
+			cluster.Shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CloudControllerManagerName]).To(And(
+				HaveKeyWithValue("replicas", 0),
+				Not(HaveKey("replicasOverwrite")),
+			))
+		})
+
+		It("should enable strict topology for the csi-provisioner", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				Storage: &apisgcp.Storage{
+					Topology: &apisgcp.StorageTopology{
+						StrictTopology: ptr.To(true),
+					},
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CSIControllerName]).To(HaveKeyWithValue("csiProvisioner", map[string]interface{}{
+				"strictTopology": true,
+			}))
+		})
+
 		DescribeTable("topologyAwareRoutingEnabled value",
 			func(seedSettings *gardencorev1beta1.SeedSettings, shootControlPlane *gardencorev1beta1.ControlPlane) {
 				cluster.Seed = &gardencorev1beta1.Seed{
@@ -374,6 +742,77 @@ var _ = Describe("ValuesProvider", func() {
 				},
 			}))
 		})
+
+		It("should return correct shoot control plane chart values when disabling the csi node plugin", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				CSIDriverNode: &apisgcp.CSIDriverNodeConfig{
+					Enabled: ptr.To(false),
+				},
+			})
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(gcp.CSINodeName, map[string]interface{}{
+				"enabled":           true,
+				"kubernetesVersion": "1.28.2",
+				"nodePluginEnabled": false,
+			}))
+		})
+
+		It("should deploy the default-http-backend when ingress-gce is enabled", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				IngressGCE: &apisgcp.IngressGCEConfig{
+					Enabled: ptr.To(true),
+				},
+			})
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("default-http-backend", map[string]interface{}{
+				"enabled": true,
+			}))
+		})
+
+		It("should return correct shoot control plane chart values when configuring the load balancer defaults", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				LoadBalancer: &apisgcp.LoadBalancerConfig{
+					Type: ptr.To("Internal"),
+				},
+			})
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(gcp.CloudControllerManagerName, utils.MergeMaps(enabledTrue, map[string]interface{}{
+				"loadBalancer": map[string]interface{}{
+					"type":         "Internal",
+					"globalAccess": false,
+				},
+			})))
+		})
+
+		It("should return correct shoot control plane chart values when configuring the network tier and source ranges", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				LoadBalancer: &apisgcp.LoadBalancerConfig{
+					NetworkTier:  ptr.To("Standard"),
+					SourceRanges: []string{"10.0.0.0/8", "192.168.0.0/16"},
+				},
+			})
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(gcp.CloudControllerManagerName, utils.MergeMaps(enabledTrue, map[string]interface{}{
+				"loadBalancer": map[string]interface{}{
+					"type":         "External",
+					"globalAccess": false,
+					"networkTier":  "Standard",
+					"sourceRanges": "10.0.0.0/8,192.168.0.0/16",
+				},
+			})))
+		})
 	})
 	Describe("#GetStorageClassesChartValues()", func() {
 		It("should return correct storage class chart values when using managed classes", func() {
@@ -400,6 +839,177 @@ var _ = Describe("ValuesProvider", func() {
 				"managedDefaultVolumeSnapshotClass": false,
 			}))
 		})
+
+		It("should not deploy the storage classes if the node plugin is disabled", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				CSIDriverNode: &apisgcp.CSIDriverNodeConfig{
+					Enabled: ptr.To(false),
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"managedDefaultStorageClass":        true,
+				"managedDefaultVolumeSnapshotClass": true,
+				"storageClassesEnabled":             false,
+			}))
+		})
+
+		It("should return correct storage class chart values when disabling volume expansion", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					AllowVolumeExpansion: ptr.To(false),
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"managedDefaultStorageClass":        true,
+				"managedDefaultVolumeSnapshotClass": true,
+				"allowVolumeExpansion":              false,
+			}))
+		})
+
+		It("should return correct storage class chart values when configuring the topology", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					Topology: &apisgcp.StorageTopology{
+						AllowedZones:      []string{"europe-west1-b", "europe-west1-c"},
+						VolumeBindingMode: ptr.To("Immediate"),
+					},
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"managedDefaultStorageClass":        true,
+				"managedDefaultVolumeSnapshotClass": true,
+				"volumeBindingMode":                 "Immediate",
+				"allowedZones":                      []string{"europe-west1-b", "europe-west1-c"},
+			}))
+		})
+
+		It("should return correct storage class chart values when configuring the volume snapshot class", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					VolumeSnapshotClass: &apisgcp.VolumeSnapshotClass{
+						SnapshotType:    ptr.To("images"),
+						StorageLocation: ptr.To("europe-west1"),
+						Labels:          map[string]string{"foo": "bar", "baz": "qux"},
+						DeletionPolicy:  ptr.To("Retain"),
+					},
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"managedDefaultStorageClass":        true,
+				"managedDefaultVolumeSnapshotClass": true,
+				"volumeSnapshotClass": map[string]interface{}{
+					"deletionPolicy": "Retain",
+					"parameters": map[string]interface{}{
+						"snapshot-type":     "images",
+						"storage-locations": "europe-west1",
+						"labels":            "baz=qux,foo=bar",
+					},
+				},
+			}))
+		})
+
+		It("should return correct storage class chart values when configuring the storage classes", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					StorageClasses: []apisgcp.StorageClass{
+						{Name: "default", Type: "hyperdisk-balanced", Default: ptr.To(true), ProvisionedIops: ptr.To[int64](3000), ProvisionedThroughput: ptr.To[int64](140)},
+						{Name: "regional", Type: "pd-balanced", ReplicationType: ptr.To("regional-pd")},
+					},
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"managedDefaultStorageClass":        true,
+				"managedDefaultVolumeSnapshotClass": true,
+				"storageClasses": []map[string]interface{}{
+					{
+						"name":    "default",
+						"default": true,
+						"parameters": map[string]interface{}{
+							"type":                             "hyperdisk-balanced",
+							"provisioned-iops-on-create":       "3000",
+							"provisioned-throughput-on-create": "140Mi",
+						},
+					},
+					{
+						"name":    "regional",
+						"default": false,
+						"parameters": map[string]interface{}{
+							"type":             "pd-balanced",
+							"replication-type": "regional-pd",
+						},
+					},
+				},
+			}))
+		})
+
+		It("should render the storage class chart with the configured storage classes", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					AllowVolumeExpansion: ptr.To(false),
+					StorageClasses: []apisgcp.StorageClass{
+						{Name: "default", Type: "hyperdisk-balanced", Default: ptr.To(true)},
+						{Name: "regional", Type: "pd-balanced", ReplicationType: ptr.To("regional-pd")},
+					},
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			renderer := chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.31.0"})
+			release, err := renderer.RenderEmbeddedFS(charts.InternalChart, filepath.Join(charts.InternalChartsPath, "shoot-storageclasses"), "shoot-storageclasses", metav1.NamespaceSystem, values)
+			Expect(err).NotTo(HaveOccurred())
+
+			manifest := string(release.Manifest())
+			Expect(manifest).To(ContainSubstring("name: default"))
+			Expect(manifest).To(ContainSubstring("name: regional"))
+			Expect(manifest).To(ContainSubstring("allowVolumeExpansion: false"))
+			Expect(manifest).NotTo(ContainSubstring("allowVolumeExpansion: true"))
+		})
+
+		It("should return correct storage class chart values when referencing storage pools", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					StorageClasses: []apisgcp.StorageClass{
+						{Name: "pooled", Type: "hyperdisk-balanced", StoragePools: []string{"pool-b", "pool-c"}},
+					},
+					StoragePools: []apisgcp.StoragePool{
+						{Name: "pool-b", Zone: "europe-west1-b", Type: "hyperdisk-balanced", ProvisionedCapacity: 10240, ProvisionedIops: ptr.To[int64](10000), ProvisionedThroughput: 1024},
+						{Name: "pool-c", Zone: "europe-west1-c", Type: "hyperdisk-balanced", ProvisionedCapacity: 10240, ProvisionedIops: ptr.To[int64](10000), ProvisionedThroughput: 1024},
+					},
+				},
+			})
+
+			c.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("storageClasses", []map[string]interface{}{
+				{
+					"name":    "pooled",
+					"default": false,
+					"parameters": map[string]interface{}{
+						"type":          "hyperdisk-balanced",
+						"storage-pools": "projects/abc/zones/europe-west1-b/storagePools/test-pool-b,projects/abc/zones/europe-west1-c/storagePools/test-pool-c",
+					},
+				},
+			}))
+		})
 	})
 })
 
@@ -7,19 +7,24 @@ package dnsrecord
 import (
 	"context"
 	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
@@ -45,46 +50,82 @@ func NewActuator(mgr manager.Manager, gcpClientFactory gcpclient.Factory) dnsrec
 
 // Reconcile reconciles the DNSRecord.
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
+	dnsRecordConfig, err := dnsRecordConfigFromDNSRecord(dns)
+	if err != nil {
+		return err
+	}
+
 	// Create GCP DNS client
 	dnsClient, err := a.gcpClientFactory.DNS(ctx, a.client, dns.Spec.SecretRef)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	// Determine DNS managed zone
-	managedZone, err := a.getManagedZone(ctx, log, dns, dnsClient)
+	managedZone, err := a.getManagedZone(ctx, log, dns, dnsRecordConfig, dnsClient)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
+	}
+
+	values, err := a.getValues(ctx, log, dns, dnsRecordConfig)
+	if err != nil {
+		return err
 	}
 
-	// Create or update DNS recordset
 	ttl := extensionsv1alpha1helper.GetDNSRecordTTL(dns.Spec.TTL)
-	log.Info("Creating or updating DNS recordset", "managedZone", managedZone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "rrdatas", dns.Spec.Values, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
-	if err := dnsClient.CreateOrUpdateRecordSet(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl); err != nil {
+	if routingPolicyItem := routingPolicyItemFromDNSRecordConfig(dnsRecordConfig); routingPolicyItem != nil {
+		// Create or update the item of the DNS recordset routing policy
+		log.Info("Creating or updating DNS recordset routing policy item", "managedZone", managedZone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "rrdatas", values, "routingPolicyItem", *routingPolicyItem, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+		if err := dnsClient.CreateOrUpdateRoutingPolicyItem(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), *routingPolicyItem, values, ttl); err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not create or update DNS recordset routing policy item in managed zone %s with name %s, type %s, and rrdatas %v: %+v", managedZone, dns.Spec.Name, dns.Spec.RecordType, values, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+		return a.updateStatus(ctx, dns, managedZone)
+	}
+
+	// Create or update DNS recordset
+	log.Info("Creating or updating DNS recordset", "managedZone", managedZone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "rrdatas", values, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+	if err := dnsClient.CreateOrUpdateRecordSet(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), values, ttl); err != nil {
 		return &reconcilerutils.RequeueAfterError{
-			Cause:        fmt.Errorf("could not create or update DNS recordset in managed zone %s with name %s, type %s, and rrdatas %v: %+v", managedZone, dns.Spec.Name, dns.Spec.RecordType, dns.Spec.Values, err),
+			Cause:        fmt.Errorf("could not create or update DNS recordset in managed zone %s with name %s, type %s, and rrdatas %v: %+v", managedZone, dns.Spec.Name, dns.Spec.RecordType, values, err),
 			RequeueAfter: requeueAfterOnProviderError,
 		}
 	}
 
-	// Update resource status
-	patch := k8sclient.MergeFrom(dns.DeepCopy())
-	dns.Status.Zone = &managedZone
-	return a.client.Status().Patch(ctx, dns, patch)
+	return a.updateStatus(ctx, dns, managedZone)
 }
 
 // Delete deletes the DNSRecord.
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
+	dnsRecordConfig, err := dnsRecordConfigFromDNSRecord(dns)
+	if err != nil {
+		return err
+	}
+
 	// Create GCP DNS client
 	dnsClient, err := a.gcpClientFactory.DNS(ctx, a.client, dns.Spec.SecretRef)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	// Determine DNS managed zone
-	managedZone, err := a.getManagedZone(ctx, log, dns, dnsClient)
+	managedZone, err := a.getManagedZone(ctx, log, dns, dnsRecordConfig, dnsClient)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
+	}
+
+	if routingPolicyItem := routingPolicyItemFromDNSRecordConfig(dnsRecordConfig); routingPolicyItem != nil {
+		// Delete the item of the DNS recordset routing policy
+		log.Info("Deleting DNS recordset routing policy item", "managedZone", managedZone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "routingPolicyItem", *routingPolicyItem, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+		if err := dnsClient.DeleteRoutingPolicyItem(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), *routingPolicyItem); err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not delete DNS recordset routing policy item in managed zone %s with name %s and type %s: %+v", managedZone, dns.Spec.Name, dns.Spec.RecordType, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+		return a.deleteManagedZone(ctx, log, dns, dnsRecordConfig, dnsClient, managedZone)
 	}
 
 	// Delete DNS recordset
@@ -95,6 +136,23 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, dns *extensionsv
 			RequeueAfter: requeueAfterOnProviderError,
 		}
 	}
+	return a.deleteManagedZone(ctx, log, dns, dnsRecordConfig, dnsClient, managedZone)
+}
+
+// deleteManagedZone deletes the managed zone of the DNSRecord if it was created automatically. Managed zones which still
+// contain other recordsets are kept and deleted together with the last DNSRecord.
+func (a *actuator) deleteManagedZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, dnsRecordConfig *api.DNSRecordConfig, dnsClient gcpclient.DNSClient, managedZone string) error {
+	if dnsRecordConfig == nil || dnsRecordConfig.ManagedZone == nil {
+		return nil
+	}
+
+	log.Info("Deleting managed zone if it is empty", "managedZone", managedZone, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+	if err := dnsClient.DeleteManagedZone(ctx, managedZone); err != nil {
+		return &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("could not delete managed zone %s: %+v", managedZone, err),
+			RequeueAfter: requeueAfterOnProviderError,
+		}
+	}
 	return nil
 }
 
@@ -113,16 +171,51 @@ func (a *actuator) Migrate(context.Context, logr.Logger, *extensionsv1alpha1.DNS
 	return nil
 }
 
-func (a *actuator) getManagedZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, dnsClient gcpclient.DNSClient) (string, error) {
+func (a *actuator) updateStatus(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, managedZone string) error {
+	patch := k8sclient.MergeFrom(dns.DeepCopy())
+	dns.Status.Zone = &managedZone
+	return a.client.Status().Patch(ctx, dns, patch)
+}
+
+// dnsRecordConfigFromDNSRecord decodes and validates the provider config of the given DNSRecord.
+func dnsRecordConfigFromDNSRecord(dns *extensionsv1alpha1.DNSRecord) (*api.DNSRecordConfig, error) {
+	dnsRecordConfig, err := helper.DNSRecordConfigFromDNSRecord(dns)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode provider config of DNSRecord: %w", err)
+	}
+	if errs := validation.ValidateDNSRecordConfig(dnsRecordConfig, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid provider config of DNSRecord: %w", errs.ToAggregate())
+	}
+	return dnsRecordConfig, nil
+}
+
+// routingPolicyItemFromDNSRecordConfig returns the item of the routing policy which is managed by a DNSRecord with the
+// given config, or nil if the config does not contain a routing policy.
+func routingPolicyItemFromDNSRecordConfig(dnsRecordConfig *api.DNSRecordConfig) *gcpclient.RoutingPolicyItem {
+	routingPolicy := dnsRecordConfig.RoutingPolicy
+	if routingPolicy == nil {
+		return nil
+	}
+	if routingPolicy.Type == api.DNSRoutingPolicyGeolocation {
+		return &gcpclient.RoutingPolicyItem{Location: *routingPolicy.Location}
+	}
+	return &gcpclient.RoutingPolicyItem{Index: int(*routingPolicy.Index), Weight: float64(*routingPolicy.Weight)}
+}
+
+func (a *actuator) getManagedZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, dnsRecordConfig *api.DNSRecordConfig, dnsClient gcpclient.DNSClient) (string, error) {
 	switch {
 	case dns.Spec.Zone != nil && *dns.Spec.Zone != "":
+		// Managed zones without a project are looked up in the configured project, if any.
+		if dnsRecordConfig.ProjectID != nil && !strings.Contains(*dns.Spec.Zone, "/") {
+			return *dnsRecordConfig.ProjectID + "/" + *dns.Spec.Zone, nil
+		}
 		return *dns.Spec.Zone, nil
 	case dns.Status.Zone != nil && *dns.Status.Zone != "":
 		return *dns.Status.Zone, nil
 	default:
 		// The zone is not specified in the resource status or spec. Try to determine the zone by
-		// getting all managed zones of the account and searching for the longest zone name that is a suffix of dns.spec.Name
-		zones, err := dnsClient.GetManagedZones(ctx)
+		// getting all managed zones of the project and searching for the longest zone name that is a suffix of dns.spec.Name
+		zones, err := dnsClient.GetManagedZones(ctx, ptr.Deref(dnsRecordConfig.ProjectID, ""))
 		if err != nil {
 			return "", &reconcilerutils.RequeueAfterError{
 				Cause:        fmt.Errorf("could not get DNS managed zones: %+v", err),
@@ -132,8 +225,89 @@ func (a *actuator) getManagedZone(ctx context.Context, log logr.Logger, dns *ext
 		log.Info("Got DNS managed zones", "zones", zones, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
 		zone := dnsrecord.FindZoneForName(zones, dns.Spec.Name)
 		if zone == "" {
+			if dnsRecordConfig.ManagedZone != nil {
+				return a.createManagedZone(ctx, log, dns, dnsRecordConfig, dnsClient)
+			}
 			return "", fmt.Errorf("could not find DNS managed zone for name %s", dns.Spec.Name)
 		}
 		return zone, nil
 	}
 }
+
+// createManagedZone creates the managed zone configured in the given DNSRecordConfig and returns its ID.
+func (a *actuator) createManagedZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, dnsRecordConfig *api.DNSRecordConfig, dnsClient gcpclient.DNSClient) (string, error) {
+	managedZone := dnsRecordConfig.ManagedZone
+	if !dnsrecord.MatchesDomain(dns.Spec.Name, managedZone.DNSName) {
+		return "", fmt.Errorf("DNS name %s of the managed zone to create is not a suffix of name %s", managedZone.DNSName, dns.Spec.Name)
+	}
+
+	spec := gcpclient.ManagedZoneSpec{
+		DNSName:  managedZone.DNSName,
+		Private:  ptr.Deref(managedZone.Visibility, api.DNSManagedZoneVisibilityPublic) == api.DNSManagedZoneVisibilityPrivate,
+		Networks: managedZone.Networks,
+		DNSSEC:   ptr.Deref(managedZone.DNSSEC, false),
+	}
+
+	log.Info("Creating DNS managed zone", "dnsName", spec.DNSName, "private", spec.Private, "dnssec", spec.DNSSEC, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+	zone, err := dnsClient.CreateManagedZone(ctx, ptr.Deref(dnsRecordConfig.ProjectID, ""), spec)
+	if err != nil {
+		return "", &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("could not create DNS managed zone with DNS name %s: %+v", spec.DNSName, err),
+			RequeueAfter: requeueAfterOnProviderError,
+		}
+	}
+	return zone, nil
+}
+
+// getValues returns the values of the given DNSRecord. If its config has a target, the IP address of the target is
+// resolved and returned instead of the values in its spec. Changes of the IP address of the target do not trigger a
+// reconciliation, they are only picked up by the periodic resync of the DNSRecord controller.
+func (a *actuator) getValues(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, dnsRecordConfig *api.DNSRecordConfig) ([]string, error) {
+	target := dnsRecordConfig.Target
+	if target == nil {
+		return dns.Spec.Values, nil
+	}
+	if dns.Spec.RecordType != extensionsv1alpha1.DNSRecordTypeA && dns.Spec.RecordType != extensionsv1alpha1.DNSRecordTypeAAAA {
+		return nil, fmt.Errorf("target of DNSRecord is only supported for record types %s and %s", extensionsv1alpha1.DNSRecordTypeA, extensionsv1alpha1.DNSRecordTypeAAAA)
+	}
+
+	computeClient, err := a.gcpClientFactory.Compute(ctx, a.client, dns.Spec.SecretRef)
+	if err != nil {
+		return nil, helper.DetermineError(err)
+	}
+
+	forwardingRule, err := computeClient.GetForwardingRule(ctx, ptr.Deref(target.Region, ""), target.ForwardingRule)
+	if err != nil {
+		return nil, &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("could not get forwarding rule %s: %+v", target.ForwardingRule, err),
+			RequeueAfter: requeueAfterOnProviderError,
+		}
+	}
+	if forwardingRule == nil {
+		return nil, fmt.Errorf("could not find forwarding rule %s", target.ForwardingRule)
+	}
+
+	ip := parseForwardingRuleIP(forwardingRule.IPAddress)
+	if ip == nil {
+		return nil, fmt.Errorf("forwarding rule %s has no valid IP address: %q", target.ForwardingRule, forwardingRule.IPAddress)
+	}
+	if isIPv4 := ip.To4() != nil; isIPv4 != (dns.Spec.RecordType == extensionsv1alpha1.DNSRecordTypeA) {
+		return nil, fmt.Errorf("IP address %s of forwarding rule %s does not match record type %s", ip, target.ForwardingRule, dns.Spec.RecordType)
+	}
+
+	log.Info("Resolved target of DNSRecord", "forwardingRule", target.ForwardingRule, "ip", ip.String(), "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+	return []string{ip.String()}, nil
+}
+
+// parseForwardingRuleIP parses the IP address of a forwarding rule. IPv6 forwarding rules may carry the prefix length
+// of their address range, e.g. 2600:1900:4010:1:0:0:0:0/96, in which case the first address of the range is returned.
+func parseForwardingRuleIP(ipAddress string) net.IP {
+	if strings.Contains(ipAddress, "/") {
+		ip, _, err := net.ParseCIDR(ipAddress)
+		if err != nil {
+			return nil
+		}
+		return ip
+	}
+	return net.ParseIP(ipAddress)
+}
@@ -14,15 +14,19 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/dnsrecord"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
 )
 
@@ -43,6 +47,7 @@ var _ = Describe("Actuator", func() {
 		sw               *mockclient.MockStatusWriter
 		gcpClientFactory *mockgcpclient.MockFactory
 		gcpDNSClient     *mockgcpclient.MockDNSClient
+		gcpComputeClient *mockgcpclient.MockComputeClient
 		ctx              context.Context
 		logger           logr.Logger
 		a                dnsrecord.Actuator
@@ -61,6 +66,7 @@ var _ = Describe("Actuator", func() {
 		sw = mockclient.NewMockStatusWriter(ctrl)
 		gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
 		gcpDNSClient = mockgcpclient.NewMockDNSClient(ctrl)
+		gcpComputeClient = mockgcpclient.NewMockComputeClient(ctrl)
 
 		c.EXPECT().Status().Return(sw).AnyTimes()
 
@@ -102,7 +108,7 @@ var _ = Describe("Actuator", func() {
 	Describe("#Reconcile", func() {
 		It("should reconcile the DNSRecord", func() {
 			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
-			gcpDNSClient.EXPECT().GetManagedZones(ctx).Return(zones, nil)
+			gcpDNSClient.EXPECT().GetManagedZones(ctx, "").Return(zones, nil)
 			gcpDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
 			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
 				func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, _ ...client.PatchOption) error {
@@ -116,6 +122,108 @@ var _ = Describe("Actuator", func() {
 			err := a.Reconcile(ctx, logger, dns, nil)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should reconcile the DNSRecord in the managed zones of the configured project", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","projectID":"dns-project"}`)}
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().GetManagedZones(ctx, "dns-project").Return(map[string]string{shootDomain: "dns-project/" + zone}, nil)
+			gcpDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, "dns-project/"+zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dns.Status.Zone).To(PointTo(Equal("dns-project/" + zone)))
+		})
+
+		It("should qualify the specified managed zone with the configured project", func() {
+			dns.Spec.Zone = ptr.To(zone)
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","projectID":"dns-project"}`)}
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, "dns-project/"+zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reconcile the routing policy item of the DNSRecord", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","routingPolicy":{"type":"weighted","index":1,"weight":20}}`)}
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().GetManagedZones(ctx, "").Return(zones, nil)
+			gcpDNSClient.EXPECT().CreateOrUpdateRoutingPolicyItem(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), gcpclient.RoutingPolicyItem{Index: 1, Weight: 20}, []string{address}, int64(120)).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reconcile the DNSRecord with the IP address of a global forwarding rule", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","target":{"forwardingRule":"lb"}}`)}
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().GetManagedZones(ctx, "").Return(zones, nil)
+			gcpClientFactory.EXPECT().Compute(ctx, c, dns.Spec.SecretRef).Return(gcpComputeClient, nil)
+			gcpComputeClient.EXPECT().GetForwardingRule(ctx, "", "lb").Return(&compute.ForwardingRule{IPAddress: "5.6.7.8"}, nil)
+			gcpDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{"5.6.7.8"}, int64(120)).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reconcile the DNSRecord with the IPv6 address of a regional forwarding rule", func() {
+			dns.Spec.RecordType = extensionsv1alpha1.DNSRecordTypeAAAA
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","target":{"forwardingRule":"lb","region":"europe-west1"}}`)}
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().GetManagedZones(ctx, "").Return(zones, nil)
+			gcpClientFactory.EXPECT().Compute(ctx, c, dns.Spec.SecretRef).Return(gcpComputeClient, nil)
+			gcpComputeClient.EXPECT().GetForwardingRule(ctx, "europe-west1", "lb").Return(&compute.ForwardingRule{IPAddress: "2600:1900:4010:1:0:0:0:0/96"}, nil)
+			gcpDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeAAAA), []string{"2600:1900:4010:1::"}, int64(120)).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if the IP address of the forwarding rule does not match the record type", func() {
+			dns.Spec.RecordType = extensionsv1alpha1.DNSRecordTypeAAAA
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","target":{"forwardingRule":"lb"}}`)}
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().GetManagedZones(ctx, "").Return(zones, nil)
+			gcpClientFactory.EXPECT().Compute(ctx, c, dns.Spec.SecretRef).Return(gcpComputeClient, nil)
+			gcpComputeClient.EXPECT().GetForwardingRule(ctx, "", "lb").Return(&compute.ForwardingRule{IPAddress: "5.6.7.8"}, nil)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError(ContainSubstring("does not match record type AAAA")))
+		})
+
+		It("should create the managed zone of the DNSRecord if it does not exist", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","managedZone":{"dnsName":"` + shootDomain + `","dnssec":true}}`)}
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().GetManagedZones(ctx, "").Return(map[string]string{"other.com": "zone3"}, nil)
+			gcpDNSClient.EXPECT().CreateManagedZone(ctx, "", gcpclient.ManagedZoneSpec{DNSName: shootDomain, DNSSEC: true}).Return("project/shoot-example-com", nil)
+			gcpDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, "project/shoot-example-com", domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dns.Status.Zone).To(PointTo(Equal("project/shoot-example-com")))
+		})
+
+		It("should not create a managed zone whose DNS name does not match the DNSRecord", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","managedZone":{"dnsName":"other.org"}}`)}
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().GetManagedZones(ctx, "").Return(map[string]string{"other.com": "zone3"}, nil)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError(ContainSubstring("is not a suffix of name")))
+		})
+
+		It("should fail if the routing policy of the DNSRecord is invalid", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","routingPolicy":{"type":"geolocation"}}`)}
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError(ContainSubstring("invalid provider config of DNSRecord")))
+		})
 	})
 
 	Describe("#Delete", func() {
@@ -127,5 +235,26 @@ var _ = Describe("Actuator", func() {
 			err := a.Delete(ctx, logger, dns, nil)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should delete the routing policy item of the DNSRecord", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","routingPolicy":{"type":"geolocation","location":"europe-west1"}}`)}
+			dns.Status.Zone = ptr.To(zone)
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().DeleteRoutingPolicyItem(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), gcpclient.RoutingPolicyItem{Location: "europe-west1"}).Return(nil)
+
+			err := a.Delete(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should delete the automatically created managed zone of the DNSRecord", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","managedZone":{"dnsName":"` + shootDomain + `"}}`)}
+			dns.Status.Zone = ptr.To("project/shoot-example-com")
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().DeleteRecordSet(ctx, "project/shoot-example-com", domainName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil)
+			gcpDNSClient.EXPECT().DeleteManagedZone(ctx, "project/shoot-example-com").Return(nil)
+
+			err := a.Delete(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
 	})
 })
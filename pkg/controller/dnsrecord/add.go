@@ -6,12 +6,15 @@ package dnsrecord
 
 import (
 	"context"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/resync"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
@@ -29,14 +32,25 @@ type AddOptions struct {
 	IgnoreOperationAnnotation bool
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// ResyncPeriod is the period after which the resources are reconciled again even if they did not change.
+	ResyncPeriod time.Duration
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
+	controllerOptions := resync.ControllerOptions(opts.Controller, resync.Args{
+		Reader:            mgr.GetClient(),
+		NewObjectFunc:     func() client.Object { return &extensionsv1alpha1.DNSRecord{} },
+		NewObjectListFunc: func() client.ObjectList { return &extensionsv1alpha1.DNSRecordList{} },
+		Type:              gcp.DNSType,
+		ExtensionClass:    opts.ExtensionClass,
+		Period:            opts.ResyncPeriod,
+	})
+
 	return dnsrecord.Add(mgr, dnsrecord.AddArgs{
 		Actuator:          NewActuator(mgr, gcpclient.New()),
-		ControllerOptions: opts.Controller,
+		ControllerOptions: controllerOptions,
 		Predicates:        dnsrecord.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              gcp.DNSType,
 		ExtensionClass:    opts.ExtensionClass,
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+)
+
+const (
+	// ReasonBucketCreated is the reason of the events which state that a bucket was created.
+	ReasonBucketCreated = "BucketCreated"
+	// ReasonBucketLocked is the reason of the events which state that the retention policy of a bucket was locked.
+	ReasonBucketLocked = "BucketLocked"
+	// ReasonVPCCreated is the reason of the events which state that a VPC was created.
+	ReasonVPCCreated = "VPCCreated"
+	// ReasonNATIPAllocated is the reason of the events which state that an IP address was allocated to a Cloud NAT.
+	ReasonNATIPAllocated = "NATIPAllocated"
+	// ReasonMachineImageResolved is the reason of the events which state that a machine image of a worker pool was
+	// resolved to a GCP image.
+	ReasonMachineImageResolved = "MachineImageResolved"
+	// ReasonRestoredStateMismatch is the reason of the events which state that the resources in GCP do not match the
+	// state which was restored during the control plane migration.
+	ReasonRestoredStateMismatch = "RestoredStateMismatch"
+)
+
+// Recorder records events for notable operations in GCP. The events are recorded for the extension resources in the
+// seed and mirrored for the Shoots in the garden, so that users can follow the provisioning of their shoots without
+// access to the seed or to the logs of the extension.
+type Recorder struct {
+	recorder       record.EventRecorder
+	gardenRecorder record.EventRecorder
+}
+
+// NewRecorder returns a Recorder which records the events with the given recorder and mirrors them with the given
+// garden recorder. The events are not mirrored if the garden recorder is nil.
+func NewRecorder(recorder, gardenRecorder record.EventRecorder) *Recorder {
+	return &Recorder{
+		recorder:       recorder,
+		gardenRecorder: gardenRecorder,
+	}
+}
+
+// NewRecorderForClusters returns a Recorder which records the events in the given seed cluster and mirrors them in the
+// given garden cluster, if it is not nil. The events are recorded with the given name as source.
+func NewRecorderForClusters(name string, seedCluster, gardenCluster cluster.Cluster) *Recorder {
+	var gardenRecorder record.EventRecorder
+	if gardenCluster != nil {
+		gardenRecorder = gardenCluster.GetEventRecorderFor(name)
+	}
+	return NewRecorder(seedCluster.GetEventRecorderFor(name), gardenRecorder)
+}
+
+// Eventf records a normal event for the given extension resource and mirrors it for the given Shoot, if it is not nil.
+// Nothing is recorded by a nil Recorder.
+func (r *Recorder) Eventf(obj runtime.Object, shoot *gardencorev1beta1.Shoot, reason, messageFmt string, args ...any) {
+	r.eventf(obj, shoot, corev1.EventTypeNormal, reason, messageFmt, args...)
+}
+
+// Warningf records a warning event for the given extension resource and mirrors it for the given Shoot, if it is not
+// nil. Nothing is recorded by a nil Recorder.
+func (r *Recorder) Warningf(obj runtime.Object, shoot *gardencorev1beta1.Shoot, reason, messageFmt string, args ...any) {
+	r.eventf(obj, shoot, corev1.EventTypeWarning, reason, messageFmt, args...)
+}
+
+func (r *Recorder) eventf(obj runtime.Object, shoot *gardencorev1beta1.Shoot, eventType, reason, messageFmt string, args ...any) {
+	if r == nil {
+		return
+	}
+
+	r.recorder.Eventf(obj, eventType, reason, messageFmt, args...)
+	if r.gardenRecorder != nil && shoot != nil && shoot.Name != "" {
+		r.gardenRecorder.Eventf(shoot, eventType, reason, messageFmt, args...)
+	}
+}
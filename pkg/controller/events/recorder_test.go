@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
+)
+
+var _ = Describe("Recorder", func() {
+	var (
+		seedRecorder   *record.FakeRecorder
+		gardenRecorder *record.FakeRecorder
+
+		infra *extensionsv1alpha1.Infrastructure
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		seedRecorder = record.NewFakeRecorder(10)
+		gardenRecorder = record.NewFakeRecorder(10)
+
+		infra = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"}}
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-foo", Name: "bar"}}
+	})
+
+	It("should record the event for the resource and mirror it for the shoot", func() {
+		NewRecorder(seedRecorder, gardenRecorder).Eventf(infra, shoot, ReasonVPCCreated, "VPC %q created", "shoot--foo--bar")
+
+		Expect(seedRecorder.Events).To(Receive(Equal(`Normal VPCCreated VPC "shoot--foo--bar" created`)))
+		Expect(gardenRecorder.Events).To(Receive(Equal(`Normal VPCCreated VPC "shoot--foo--bar" created`)))
+	})
+
+	It("should not mirror the event if there is no shoot", func() {
+		NewRecorder(seedRecorder, gardenRecorder).Eventf(infra, nil, ReasonVPCCreated, "VPC %q created", "shoot--foo--bar")
+
+		Expect(seedRecorder.Events).To(Receive())
+		Expect(gardenRecorder.Events).NotTo(Receive())
+	})
+
+	It("should not mirror the event without garden recorder", func() {
+		NewRecorder(seedRecorder, nil).Eventf(infra, shoot, ReasonVPCCreated, "VPC %q created", "shoot--foo--bar")
+
+		Expect(seedRecorder.Events).To(Receive())
+	})
+
+	It("should not record anything with a nil recorder", func() {
+		var recorder *Recorder
+
+		Expect(func() { recorder.Eventf(infra, shoot, ReasonVPCCreated, "VPC created") }).NotTo(Panic())
+	})
+})
@@ -13,7 +13,6 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/general"
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/worker"
 	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,6 +24,7 @@ import (
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
 var (
@@ -70,6 +70,24 @@ func RegisterHealthChecks(_ context.Context, mgr manager.Manager, opts healthche
 		return err
 	}
 
+	if err := healthcheck.DefaultRegistration(
+		gcp.Type,
+		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.InfrastructureResource),
+		func() client.ObjectList { return &extensionsv1alpha1.InfrastructureList{} },
+		func() extensionsv1alpha1.Object { return &extensionsv1alpha1.Infrastructure{} },
+		mgr,
+		opts,
+		nil,
+		[]healthcheck.ConditionTypeToHealthCheck{{
+			ConditionType:      string(gardencorev1beta1.ShootSystemComponentsHealthy),
+			HealthCheck:        NewNetworkHealthChecker(gcpclient.New()),
+			ErrorCodeCheckFunc: helper.DetermineErrorCodes,
+		}},
+		sets.New[gardencorev1beta1.ConditionType](),
+	); err != nil {
+		return err
+	}
+
 	return healthcheck.DefaultRegistration(
 		gcp.Type,
 		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.WorkerResource),
@@ -79,11 +97,9 @@ func RegisterHealthChecks(_ context.Context, mgr manager.Manager, opts healthche
 		opts,
 		nil,
 		[]healthcheck.ConditionTypeToHealthCheck{{
-			ConditionType: string(gardencorev1beta1.ShootEveryNodeReady),
-			HealthCheck:   worker.NewNodesChecker(),
-			ErrorCodeCheckFunc: func(err error) []gardencorev1beta1.ErrorCode {
-				return util.DetermineErrorCodes(err, helper.KnownCodes)
-			},
+			ConditionType:      string(gardencorev1beta1.ShootEveryNodeReady),
+			HealthCheck:        worker.NewNodesChecker(),
+			ErrorCodeCheckFunc: helper.DetermineErrorCodes,
 		}},
 		sets.New(gardencorev1beta1.ShootControlPlaneHealthy),
 	)
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// NetworkHealthChecker checks that the Cloud Router, the Cloud NAT and the firewall rules of a shoot still exist in
+// GCP and have not been modified in a way that breaks the data plane of the shoot.
+type NetworkHealthChecker struct {
+	logger           logr.Logger
+	seedClient       client.Client
+	gcpClientFactory gcpclient.Factory
+}
+
+// NewNetworkHealthChecker returns a health check which checks the network resources of the Infrastructure.
+func NewNetworkHealthChecker(gcpClientFactory gcpclient.Factory) healthcheck.HealthCheck {
+	return &NetworkHealthChecker{
+		gcpClientFactory: gcpClientFactory,
+	}
+}
+
+// InjectSeedClient injects the seed client
+func (n *NetworkHealthChecker) InjectSeedClient(seedClient client.Client) {
+	n.seedClient = seedClient
+}
+
+// SetLoggerSuffix injects the logger
+func (n *NetworkHealthChecker) SetLoggerSuffix(provider, extension string) {
+	n.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-network", provider, extension))
+}
+
+// DeepCopy clones the healthCheck struct by making a copy and returning the pointer to that new copy.
+func (n *NetworkHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	shallowCopy := *n
+	return &shallowCopy
+}
+
+// Check executes the health check
+func (n *NetworkHealthChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := n.seedClient.Get(ctx, request, infra); err != nil {
+		return nil, fmt.Errorf("failed to get infrastructure %q: %w", request, err)
+	}
+
+	// The network resources are not known before the first successful reconciliation of the infrastructure.
+	if infra.Status.ProviderStatus == nil {
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+	status, err := helper.InfrastructureStatusFromRaw(infra.Status.ProviderStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	computeClient, err := n.gcpClientFactory.Compute(ctx, n.seedClient, infra.Spec.SecretRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create compute client: %w", err)
+	}
+
+	var issues []string
+	firewallIssues, err := checkFirewallRules(ctx, computeClient, infra.Namespace, status)
+	if err != nil {
+		return nil, err
+	}
+	issues = append(issues, firewallIssues...)
+
+	natIssues, err := checkCloudNAT(ctx, computeClient, infra.Namespace, infra.Spec.Region, status)
+	if err != nil {
+		return nil, err
+	}
+	issues = append(issues, natIssues...)
+
+	if len(issues) > 0 {
+		n.logger.Info("Health check failed", "infrastructure", request, "issues", issues)
+		return &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: strings.Join(issues, "; "),
+		}, nil
+	}
+
+	return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+}
+
+func checkFirewallRules(ctx context.Context, computeClient gcpclient.ComputeClient, clusterName string, status *api.InfrastructureStatus) ([]string, error) {
+	names := []string{
+		infraflow.FirewallRuleAllowInternalName(clusterName),
+		infraflow.FirewallRuleAllowHealthChecksName(clusterName),
+	}
+	if slices.Contains(status.Networks.IPFamilies, gardencorev1beta1.IPFamilyIPv6) {
+		names = append(names,
+			infraflow.FirewallRuleAllowInternalNameIPv6(clusterName),
+			infraflow.FirewallRuleAllowHealthChecksNameIPv6(clusterName),
+		)
+	}
+
+	var issues []string
+	for _, name := range names {
+		rule, err := computeClient.GetFirewallRule(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get firewall rule %q: %w", name, err)
+		}
+
+		switch {
+		case rule == nil:
+			issues = append(issues, fmt.Sprintf("firewall rule %q does not exist", name))
+		case rule.Disabled:
+			issues = append(issues, fmt.Sprintf("firewall rule %q is disabled", name))
+		case len(status.Networks.VPC.Name) > 0 && path.Base(rule.Network) != status.Networks.VPC.Name:
+			issues = append(issues, fmt.Sprintf("firewall rule %q is not attached to VPC %q", name, status.Networks.VPC.Name))
+		}
+	}
+	return issues, nil
+}
+
+func checkCloudNAT(ctx context.Context, computeClient gcpclient.ComputeClient, clusterName, region string, status *api.InfrastructureStatus) ([]string, error) {
+	// Shoots without a Cloud Router do not have a Cloud NAT either.
+	if status.Networks.VPC.CloudRouter == nil {
+		return nil, nil
+	}
+
+	routerName := status.Networks.VPC.CloudRouter.Name
+	router, err := computeClient.GetRouter(ctx, region, routerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cloud router %q: %w", routerName, err)
+	}
+	if router == nil {
+		return []string{fmt.Sprintf("cloud router %q does not exist", routerName)}, nil
+	}
+
+	natName := infraflow.CloudNATName(clusterName)
+	idx := slices.IndexFunc(router.Nats, func(nat *compute.RouterNat) bool { return nat.Name == natName })
+	if idx < 0 {
+		return []string{fmt.Sprintf("cloud NAT %q does not exist in cloud router %q", natName, routerName)}, nil
+	}
+
+	// NATs which translate all subnets of the region cover the nodes subnet anyway.
+	nat := router.Nats[idx]
+	nodes, err := helper.FindSubnetByPurpose(status.Networks.Subnets, api.PurposeNodes)
+	if err == nil && nat.SourceSubnetworkIpRangesToNat == "LIST_OF_SUBNETWORKS" &&
+		!slices.ContainsFunc(nat.Subnetworks, func(subnet *compute.RouterNatSubnetworkToNat) bool { return path.Base(subnet.Name) == nodes.Name }) {
+		return []string{fmt.Sprintf("cloud NAT %q does not translate the addresses of subnet %q", natName, nodes.Name)}, nil
+	}
+	return nil, nil
+}
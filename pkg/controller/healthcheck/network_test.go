@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/healthcheck"
+	gcpclientfake "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+var _ = Describe("NetworkHealthChecker", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		region    = "europe-west1"
+	)
+
+	var (
+		ctx = context.TODO()
+
+		factory    *gcpclientfake.Factory
+		c          client.Client
+		infra      *extensionsv1alpha1.Infrastructure
+		check      healthcheck.HealthCheck
+		request    = types.NamespacedName{Namespace: namespace, Name: "infra"}
+		subnetLink = "https://www.googleapis.com/compute/v1/projects/project/regions/europe-west1/subnetworks/shoot--foo--bar-nodes"
+	)
+
+	BeforeEach(func() {
+		factory = gcpclientfake.NewFactory("project")
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "infra"},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region:    region,
+				SecretRef: corev1.SecretReference{Namespace: namespace, Name: "cloudprovider"},
+			},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				ProviderStatus: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureStatus",
+"networks": {
+  "vpc": {"name": "shoot--foo--bar", "cloudRouter": {"name": "shoot--foo--bar-cloud-router"}},
+  "subnets": [{"name": "shoot--foo--bar-nodes", "purpose": "nodes"}]
+}}`)},
+			},
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra).Build()
+
+		check = NewNetworkHealthChecker(factory)
+		check.SetLoggerSuffix("gcp", "infrastructure")
+		check.(interface{ InjectSeedClient(client.Client) }).InjectSeedClient(c)
+
+		for _, name := range []string{"shoot--foo--bar-allow-internal-access", "shoot--foo--bar-allow-health-checks"} {
+			_, err := factory.ComputeClient.InsertFirewallRule(ctx, &compute.Firewall{
+				Name:    name,
+				Network: "https://www.googleapis.com/compute/v1/projects/project/global/networks/shoot--foo--bar",
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		_, err := factory.ComputeClient.InsertRouter(ctx, region, &compute.Router{
+			Name: "shoot--foo--bar-cloud-router",
+			Nats: []*compute.RouterNat{{
+				Name:                          "shoot--foo--bar-cloud-nat",
+				SourceSubnetworkIpRangesToNat: "LIST_OF_SUBNETWORKS",
+				Subnetworks:                   []*compute.RouterNatSubnetworkToNat{{Name: subnetLink}},
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should succeed if all network resources are healthy", func() {
+		Expect(check.Check(ctx, request)).To(HaveField("Status", gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should succeed if the infrastructure has not been reconciled yet", func() {
+		Expect(c.Get(ctx, request, infra)).To(Succeed())
+		infra.Status.ProviderStatus = nil
+		Expect(c.Update(ctx, infra)).To(Succeed())
+		Expect(factory.ComputeClient.DeleteRouter(ctx, region, "shoot--foo--bar-cloud-router")).To(Succeed())
+
+		Expect(check.Check(ctx, request)).To(HaveField("Status", gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should fail if a firewall rule was deleted or disabled", func() {
+		Expect(factory.ComputeClient.DeleteFirewallRule(ctx, "shoot--foo--bar-allow-internal-access")).To(Succeed())
+		_, err := factory.ComputeClient.PatchFirewallRule(ctx, "shoot--foo--bar-allow-health-checks", &compute.Firewall{Disabled: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		result, err := check.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(And(
+			ContainSubstring(`firewall rule "shoot--foo--bar-allow-internal-access" does not exist`),
+			ContainSubstring(`firewall rule "shoot--foo--bar-allow-health-checks" is disabled`),
+		))
+	})
+
+	It("should fail if the cloud router was deleted", func() {
+		Expect(factory.ComputeClient.DeleteRouter(ctx, region, "shoot--foo--bar-cloud-router")).To(Succeed())
+
+		Expect(check.Check(ctx, request)).To(And(
+			HaveField("Status", gardencorev1beta1.ConditionFalse),
+			HaveField("Detail", `cloud router "shoot--foo--bar-cloud-router" does not exist`),
+		))
+	})
+
+	It("should fail if the cloud NAT was removed or does not cover the nodes subnet anymore", func() {
+		_, err := factory.ComputeClient.PatchRouter(ctx, region, "shoot--foo--bar-cloud-router", &compute.Router{
+			Nats: []*compute.RouterNat{{Name: "shoot--foo--bar-cloud-nat", SourceSubnetworkIpRangesToNat: "LIST_OF_SUBNETWORKS"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(check.Check(ctx, request)).To(HaveField("Detail", ContainSubstring(`does not translate the addresses of subnet "shoot--foo--bar-nodes"`)))
+
+		_, err = factory.ComputeClient.PatchRouter(ctx, region, "shoot--foo--bar-cloud-router", &compute.Router{
+			Nats: []*compute.RouterNat{{Name: "other"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(check.Check(ctx, request)).To(HaveField("Detail", ContainSubstring(`cloud NAT "shoot--foo--bar-cloud-nat" does not exist`)))
+	})
+
+	It("should return an error if the GCP API fails", func() {
+		factory.ComputeClient.FailWith("GetFirewallRule", gcpclientfake.NewAPIError(http.StatusInternalServerError, "backendError", "backend error"))
+
+		_, err := check.Check(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("backend error")))
+	})
+})
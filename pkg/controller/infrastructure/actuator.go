@@ -11,21 +11,27 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/terraformer"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
 )
 
 type actuator struct {
 	client                     client.Client
 	restConfig                 *rest.Config
 	disableProjectedTokenMount bool
+	recorder                   *events.Recorder
 }
 
-// NewActuator creates a new infrastructure.Actuator.
-func NewActuator(mgr manager.Manager, disableProjectedTokenMount bool) infrastructure.Actuator {
+// NewActuator creates a new infrastructure.Actuator. The events of the actuator are mirrored for the Shoots in the given
+// garden cluster, if it is not nil.
+func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster, disableProjectedTokenMount bool) infrastructure.Actuator {
 	return &actuator{
 		client:                     mgr.GetClient(),
 		restConfig:                 mgr.GetConfig(),
 		disableProjectedTokenMount: disableProjectedTokenMount,
+		recorder:                   events.NewRecorderForClusters(infrastructure.ControllerName, mgr, gardenCluster),
 	}
 }
 
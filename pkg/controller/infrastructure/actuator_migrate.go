@@ -8,7 +8,6 @@ import (
 	"context"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 
@@ -23,5 +22,5 @@ func (a *actuator) Migrate(ctx context.Context, log logr.Logger, infra *extensio
 	if err != nil {
 		return err
 	}
-	return util.DetermineError(CleanupTerraformerResources(ctx, tf), helper.KnownCodes)
+	return helper.DetermineError(CleanupTerraformerResources(ctx, tf))
 }
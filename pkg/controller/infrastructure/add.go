@@ -6,14 +6,19 @@ package infrastructure
 
 import (
 	"context"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/instrumentation"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/resync"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
@@ -25,6 +30,9 @@ var (
 
 // AddOptions are options to apply when adding the GCP infrastructure controller to the manager.
 type AddOptions struct {
+	// GardenCluster is the garden cluster object. The events of the controller are mirrored for the Shoots in the garden
+	// cluster, if it is set.
+	GardenCluster cluster.Cluster
 	// Controller are the controller.Options.
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
@@ -34,15 +42,26 @@ type AddOptions struct {
 	DisableProjectedTokenMount bool
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// ResyncPeriod is the period after which the resources are reconciled again even if they did not change.
+	ResyncPeriod time.Duration
 }
 
 // AddToManagerWithOptions adds a controller with the given AddOptions to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
+	controllerOptions := resync.ControllerOptions(opts.Controller, resync.Args{
+		Reader:            mgr.GetClient(),
+		NewObjectFunc:     func() client.Object { return &extensionsv1alpha1.Infrastructure{} },
+		NewObjectListFunc: func() client.ObjectList { return &extensionsv1alpha1.InfrastructureList{} },
+		Type:              gcp.Type,
+		ExtensionClass:    opts.ExtensionClass,
+		Period:            opts.ResyncPeriod,
+	})
+
 	return infrastructure.Add(mgr, infrastructure.AddArgs{
-		Actuator:          NewActuator(mgr, opts.DisableProjectedTokenMount),
+		Actuator:          instrumentation.NewInfrastructureActuator(NewActuator(mgr, opts.GardenCluster, opts.DisableProjectedTokenMount)),
 		ConfigValidator:   NewConfigValidator(mgr, log.Log, gcpclient.New()),
-		ControllerOptions: opts.Controller,
+		ControllerOptions: controllerOptions,
 		Predicates:        infrastructure.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              gcp.Type,
 		KnownCodes:        helper.KnownCodes,
@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow"
 	gcpinternal "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
@@ -29,20 +30,32 @@ type FlowReconciler struct {
 	restConfig                 *rest.Config
 	log                        logr.Logger
 	disableProjectedTokenMount bool
+	recorder                   *events.Recorder
 }
 
 // NewFlowReconciler creates a new flow reconciler.
-func NewFlowReconciler(client client.Client, restConfig *rest.Config, log logr.Logger, projToken bool) (Reconciler, error) {
+func NewFlowReconciler(client client.Client, restConfig *rest.Config, log logr.Logger, projToken bool, recorder *events.Recorder) (Reconciler, error) {
 	return &FlowReconciler{
 		client:                     client,
 		restConfig:                 restConfig,
 		log:                        log,
 		disableProjectedTokenMount: projToken,
+		recorder:                   recorder,
 	}, nil
 }
 
 // Reconcile reconciles the infrastructure and returns the status (state of the world), the state (input for the next loops) and any errors that occurred.
 func (f *FlowReconciler) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
+	fctx, err := f.reconcileFlowContext(ctx, infra, cluster)
+	if err != nil {
+		return err
+	}
+	return fctx.Reconcile(ctx)
+}
+
+// reconcileFlowContext returns a flow context for reconciling the infrastructure, whose state is initialized from the
+// flow state or, if there is none, from the Terraform state of the infrastructure.
+func (f *FlowReconciler) reconcileFlowContext(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) (*infraflow.FlowContext, error) {
 	var (
 		infraState *gcp.InfrastructureState
 		err        error
@@ -52,26 +65,26 @@ func (f *FlowReconciler) Reconcile(ctx context.Context, infra *extensionsv1alpha
 	// because no explicit migration to the new flow format is necessary, we simply return an empty state.
 	fsOk, err := hasFlowState(infra.Status.State)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if fsOk {
 		// if it had a flow state, then we just decode it.
 		infraState, err = f.infrastructureStateFromRaw(infra)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else {
 		// otherwise migrate it from the terraform state if needed.
 		infraState, err = f.migrateFromTerraform(ctx, infra)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	credentialsConfig, err := gcpinternal.GetCredentialsConfigFromSecretReference(ctx, f.client, infra.Spec.SecretRef)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	fctx, err := infraflow.NewFlowContext(ctx, infraflow.Opts{
@@ -82,12 +95,13 @@ func (f *FlowReconciler) Reconcile(ctx context.Context, infra *extensionsv1alpha
 		CredentialsConfig: credentialsConfig,
 		Factory:           gcpclient.New(),
 		Client:            f.client,
+		Recorder:          f.recorder,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to create flow context: %v", err)
+		return nil, fmt.Errorf("failed to create flow context: %v", err)
 	}
 
-	return fctx.Reconcile(ctx)
+	return fctx, nil
 }
 
 // Delete deletes the infrastructure resource using the flow reconciler.
@@ -127,9 +141,14 @@ func (f *FlowReconciler) Delete(ctx context.Context, infra *extensionsv1alpha1.I
 	return CleanupTerraformerResources(ctx, tf)
 }
 
-// Restore implements the restoration of an infrastructure resource during the control plane migration.
+// Restore implements the restoration of an infrastructure resource during the control plane migration. The resources
+// recorded in the restored state are verified before the infrastructure is reconciled.
 func (f *FlowReconciler) Restore(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
-	return f.Reconcile(ctx, infra, cluster)
+	fctx, err := f.reconcileFlowContext(ctx, infra, cluster)
+	if err != nil {
+		return err
+	}
+	return fctx.Restore(ctx)
 }
 
 func (f *FlowReconciler) infrastructureStateFromRaw(infra *extensionsv1alpha1.Infrastructure) (*gcp.InfrastructureState, error) {
@@ -15,6 +15,7 @@ import (
 	"k8s.io/utils/ptr"
 	ctclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
@@ -64,6 +65,7 @@ func (fctx *FlowContext) ensureVPC(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		fctx.recorder.Eventf(fctx.infra, fctx.shoot, events.ReasonVPCCreated, "VPC %q created", current.Name)
 	} else {
 		current, err = fctx.updater.VPC(ctx, targetVPC, current)
 		if err != nil {
@@ -73,6 +75,7 @@ func (fctx *FlowContext) ensureVPC(ctx context.Context) error {
 
 	fctx.whiteboard.Set(CreatedResourcesExistKey, "true")
 	fctx.whiteboard.SetObject(ObjectKeyVPC, current)
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(ObjectKeyVPC, current.Name)
 	return nil
 }
 
@@ -94,6 +97,7 @@ func (fctx *FlowContext) ensureUserManagedVPC(ctx context.Context) error {
 	}
 
 	fctx.whiteboard.SetObject(ObjectKeyVPC, vpc)
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(ObjectKeyVPC, vpc.Name)
 	return nil
 }
 
@@ -184,6 +188,12 @@ func (fctx *FlowContext) ensureNodesSubnet(ctx context.Context) error {
 		cidr = fctx.config.Networks.Worker
 	}
 
+	// The pod network is added as secondary range if the pod ranges of the nodes are allocated as alias IP ranges.
+	var podRange *string
+	if !gardencorev1beta1.IsIPv4SingleStack(fctx.networking.IPFamilies) || fctx.aliasIPEnabled {
+		podRange = fctx.networking.Pods
+	}
+
 	targetSubnet := targetSubnetState(
 		subnetName,
 		"gardener-managed worker subnet",
@@ -191,7 +201,7 @@ func (fctx *FlowContext) ensureNodesSubnet(ctx context.Context) error {
 		vpc.SelfLink,
 		fctx.config.Networks.FlowLogs,
 		!gardencorev1beta1.IsIPv4SingleStack(fctx.networking.IPFamilies),
-		fctx.networking.Pods,
+		podRange,
 	)
 
 	subnet, err := fctx.computeClient.GetSubnet(ctx, region, subnetName)
@@ -213,6 +223,8 @@ func (fctx *FlowContext) ensureNodesSubnet(ctx context.Context) error {
 
 	fctx.whiteboard.Set(CreatedResourcesExistKey, "true")
 	fctx.whiteboard.SetObject(ObjectKeyNodeSubnet, subnet)
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(ObjectKeyNodeSubnet, subnet.Name)
+	fctx.recordSecondaryRanges(subnet)
 	return nil
 }
 
@@ -257,6 +269,7 @@ func (fctx *FlowContext) ensureInternalSubnet(ctx context.Context) error {
 
 	fctx.whiteboard.Set(CreatedResourcesExistKey, "true")
 	fctx.whiteboard.SetObject(ObjectKeyInternalSubnet, subnet)
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(ObjectKeyInternalSubnet, subnet.Name)
 	return nil
 }
 
@@ -298,6 +311,7 @@ func (fctx *FlowContext) ensureServicesSubnet(ctx context.Context) error {
 
 	fctx.whiteboard.Set(CreatedResourcesExistKey, "true")
 	fctx.whiteboard.SetObject(ObjectKeyServicesSubnet, subnet)
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(ObjectKeyServicesSubnet, subnet.Name)
 	return nil
 }
 
@@ -334,6 +348,7 @@ func (fctx *FlowContext) ensureCloudRouter(ctx context.Context) error {
 
 	fctx.whiteboard.Set(CreatedResourcesExistKey, "true")
 	fctx.whiteboard.SetObject(ObjectKeyRouter, router)
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(ObjectKeyRouter, router.Name)
 	return nil
 }
 
@@ -354,12 +369,14 @@ func (fctx *FlowContext) ensureUserManagedCloudRouter(ctx context.Context) error
 	}
 
 	fctx.whiteboard.SetObject(ObjectKeyRouter, router)
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(ObjectKeyRouter, router.Name)
 	return nil
 }
 
 func (fctx *FlowContext) ensureAddresses(ctx context.Context) error {
 	log := shared.LogFromContext(ctx)
 	if fctx.config.Networks.CloudNAT == nil || len(fctx.config.Networks.CloudNAT.NatIPNames) == 0 {
+		fctx.recordNATIPs(nil)
 		return nil
 	}
 
@@ -376,6 +393,7 @@ func (fctx *FlowContext) ensureAddresses(ctx context.Context) error {
 	if len(addresses) > 0 {
 		fctx.whiteboard.SetObject(ObjectKeyIPAddresses, addresses)
 	}
+	fctx.recordNATIPs(addresses)
 	return nil
 }
 
@@ -406,6 +424,7 @@ func (fctx *FlowContext) ensureCloudNAT(ctx context.Context) error {
 
 	fctx.whiteboard.SetObject(ObjectKeyRouter, router)
 	fctx.whiteboard.SetObject(ObjectKeyNAT, nat)
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(ObjectKeyNAT, nat.Name)
 	fctx.whiteboard.Set(CreatedResourcesExistKey, "true")
 	return nil
 }
@@ -472,6 +491,7 @@ func (fctx *FlowContext) ensureVPCDeleted(ctx context.Context) error {
 	}
 
 	fctx.whiteboard.DeleteObject(ObjectKeyVPC)
+	fctx.whiteboard.GetChild(ChildKeyIDs).Delete(ObjectKeyVPC)
 	return nil
 }
 
@@ -486,6 +506,7 @@ func (fctx *FlowContext) ensureSubnetDeletedFactory(subnetName string, whiteboar
 		}
 
 		fctx.whiteboard.DeleteObject(whiteboardKey)
+		fctx.whiteboard.GetChild(ChildKeyIDs).Delete(whiteboardKey)
 		return nil
 	}
 }
@@ -519,6 +540,7 @@ func (fctx *FlowContext) ensureCloudRouterDeleted(ctx context.Context) error {
 		return err
 	}
 	fctx.whiteboard.DeleteObject(ObjectKeyRouter)
+	fctx.whiteboard.GetChild(ChildKeyIDs).Delete(ObjectKeyRouter)
 	return nil
 }
 
@@ -538,6 +560,7 @@ func (fctx *FlowContext) ensureCloudNATDeleted(ctx context.Context) error {
 	}
 
 	fctx.whiteboard.SetObject(ObjectKeyRouter, router)
+	fctx.whiteboard.GetChild(ChildKeyIDs).Delete(ObjectKeyNAT)
 	return nil
 }
 
@@ -18,7 +18,8 @@ const (
 	DefaultFlowSampling = 0.5
 	// DefaultMetadata is the default value for the Flow Logs metadata.
 	DefaultMetadata = "EXCLUDE_ALL_METADATA"
-	// DefaultSecondarySubnetName is the default name of the secondary ipv4 subnet that will be used in dualstack shoot
+	// DefaultSecondarySubnetName is the default name of the secondary ipv4 subnet that will be used in dualstack shoots
+	// and in shoots using alias IP ranges for the pods
 	DefaultSecondarySubnetName = "ipv4-pod-cidr"
 )
 
@@ -73,7 +74,12 @@ func (fctx *FlowContext) cloudRouterNameFromConfig() string {
 }
 
 func (fctx *FlowContext) cloudNatNameFromConfig() string {
-	return fmt.Sprintf("%s-cloud-nat", fctx.clusterName)
+	return CloudNATName(fctx.clusterName)
+}
+
+// CloudNATName returns the name of the Cloud NAT which is created for the cluster with the given name.
+func CloudNATName(base string) string {
+	return fmt.Sprintf("%s-cloud-nat", base)
 }
 
 func targetNetwork(name string) *compute.Network {
@@ -101,14 +107,14 @@ func targetSubnetState(name, description, cidr, networkName string, flowLogs *gc
 	if dualStack {
 		subnet.Ipv6AccessType = "EXTERNAL"
 		subnet.StackType = "IPV4_IPV6"
+	}
 
-		if secondaryRange != nil {
-			subnet.SecondaryIpRanges = []*compute.SubnetworkSecondaryRange{
-				{
-					IpCidrRange: *secondaryRange,
-					RangeName:   DefaultSecondarySubnetName,
-				},
-			}
+	if secondaryRange != nil {
+		subnet.SecondaryIpRanges = []*compute.SubnetworkSecondaryRange{
+			{
+				IpCidrRange: *secondaryRange,
+				RangeName:   DefaultSecondarySubnetName,
+			},
 		}
 	}
 
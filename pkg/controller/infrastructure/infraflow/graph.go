@@ -31,7 +31,7 @@ func (fctx *FlowContext) buildReconcileGraph() *flow.Graph {
 	ensureDualStackKubernetesRoutesCleanup := fctx.AddTask(g, "ensure kubernetes routes cleanup", fctx.ensureKubernetesRoutesCleanup,
 		shared.Timeout(defaultCreateTimeout),
 		shared.Dependencies(ensureVPC),
-		shared.DoIf(!gardencorev1beta1.IsIPv4SingleStack(fctx.networking.IPFamilies)),
+		shared.DoIf(!gardencorev1beta1.IsIPv4SingleStack(fctx.networking.IPFamilies) || fctx.aliasIPEnabled),
 	)
 	ensureNodesSubnet := fctx.AddTask(g, "ensure worker subnet", fctx.ensureNodesSubnet,
 		shared.Timeout(defaultCreateTimeout),
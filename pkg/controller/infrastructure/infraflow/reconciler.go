@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
 	gcpinternal "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
@@ -74,8 +75,11 @@ type FlowContext struct {
 	technicalID       string
 	runtimeClient     client.Client
 	networking        *v1beta1.Networking
+	aliasIPEnabled    bool
 	whiteboard        shared.Whiteboard
 	log               logr.Logger
+	recorder          *events.Recorder
+	shoot             *v1beta1.Shoot
 
 	computeClient gcpclient.ComputeClient
 	iamClient     gcpclient.IAMClient
@@ -94,6 +98,8 @@ type Opts struct {
 	CredentialsConfig *gcpinternal.CredentialsConfig
 	Factory           gcpclient.Factory
 	Client            client.Client
+	// Recorder records events for the notable operations in GCP. No events are recorded if it is nil.
+	Recorder *events.Recorder
 }
 
 // NewFlowContext returns a new FlowContext.
@@ -105,6 +111,11 @@ func NewFlowContext(ctx context.Context, opts Opts) (*FlowContext, error) {
 		return nil, err
 	}
 
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(opts.Cluster)
+	if err != nil {
+		return nil, err
+	}
+
 	com, err := opts.Factory.Compute(ctx, opts.Client, opts.Infra.Spec.SecretRef)
 	if err != nil {
 		return nil, err
@@ -125,7 +136,10 @@ func NewFlowContext(ctx context.Context, opts Opts) (*FlowContext, error) {
 		runtimeClient:     opts.Client,
 		technicalID:       opts.Cluster.Shoot.Status.TechnicalID,
 		log:               opts.Log,
+		recorder:          opts.Recorder,
+		shoot:             opts.Cluster.Shoot,
 		networking:        opts.Cluster.Shoot.Spec.Networking,
+		aliasIPEnabled:    helper.IsAliasIPModeEnabled(cpConfig),
 		computeClient:     com,
 		iamClient:         iam,
 	}
@@ -164,6 +178,12 @@ func (fctx *FlowContext) Reconcile(ctx context.Context) error {
 	)
 }
 
+// Restore verifies that the resources recorded in the restored state still exist and reconciles the infrastructure.
+func (fctx *FlowContext) Restore(ctx context.Context) error {
+	fctx.verifyRestoredState(ctx)
+	return fctx.Reconcile(ctx)
+}
+
 // Delete is used to destroy the infrastructure.
 func (fctx *FlowContext) Delete(ctx context.Context) error {
 	if fctx.state.Data == nil || !strings.EqualFold(fctx.state.Data[CreatedResourcesExistKey], "true") {
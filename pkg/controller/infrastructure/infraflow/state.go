@@ -0,0 +1,182 @@
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+)
+
+const (
+	// ChildKeyNATIPs is the key of the child of the ids which stores the addresses of the NAT IPs by their names.
+	ChildKeyNATIPs = "nat-ips"
+	// ChildKeySecondaryRanges is the key of the child of the ids which stores the CIDRs of the secondary ranges of the
+	// nodes subnet by their names.
+	ChildKeySecondaryRanges = "secondary-ranges"
+)
+
+// recordSecondaryRanges stores the secondary ranges of the given nodes subnet in the state.
+func (fctx *FlowContext) recordSecondaryRanges(subnet *compute.Subnetwork) {
+	ranges := map[string]string{}
+	for _, r := range subnet.SecondaryIpRanges {
+		ranges[r.RangeName] = r.IpCidrRange
+	}
+	replaceChild(fctx.whiteboard.GetChild(ChildKeyIDs).GetChild(ChildKeySecondaryRanges), ranges)
+}
+
+// recordNATIPs stores the given NAT IPs in the state. An event is recorded for every NAT IP which was not stored yet.
+func (fctx *FlowContext) recordNATIPs(addresses []*compute.Address) {
+	var (
+		recorded = fctx.whiteboard.GetChild(ChildKeyIDs).GetChild(ChildKeyNATIPs)
+		ips      = map[string]string{}
+	)
+	for _, address := range addresses {
+		if address == nil {
+			continue
+		}
+
+		ips[address.Name] = address.Address
+		if ip := recorded.Get(address.Name); ip == nil || *ip != address.Address {
+			fctx.recorder.Eventf(fctx.infra, fctx.shoot, events.ReasonNATIPAllocated, "NAT IP %q with address %s allocated to Cloud NAT", address.Name, address.Address)
+		}
+	}
+	replaceChild(recorded, ips)
+}
+
+// replaceChild replaces the values of the given whiteboard with the given data.
+func replaceChild(child shared.Whiteboard, data map[string]string) {
+	for _, key := range child.Keys() {
+		if _, ok := data[key]; !ok {
+			child.Delete(key)
+		}
+	}
+	for key, value := range data {
+		child.Set(key, value)
+	}
+}
+
+// verifyRestoredState verifies that the resources recorded in the state of the infrastructure still exist in GCP with
+// the recorded identifiers. It is called when the infrastructure is restored on a new seed during the control plane
+// migration, so that resources which were deleted or re-created out-of-band in the meantime are reported in a warning
+// event before the reconciliation replaces them. The restoration is not blocked by the verification, as it must not
+// wedge the control plane migration.
+func (fctx *FlowContext) verifyRestoredState(ctx context.Context) {
+	issues, err := fctx.restoredStateIssues(ctx)
+	if err != nil {
+		fctx.log.Error(err, "Could not verify the restored state of the infrastructure")
+		return
+	}
+	if len(issues) == 0 {
+		return
+	}
+
+	fctx.log.Info("The resources of the infrastructure do not match the restored state", "issues", issues)
+	fctx.recorder.Warningf(fctx.infra, fctx.shoot, events.ReasonRestoredStateMismatch, "The resources of the infrastructure do not match the restored state: %s", strings.Join(issues, "; "))
+}
+
+// restoredStateIssues returns the differences between the resources recorded in the state of the infrastructure and
+// the resources in GCP.
+func (fctx *FlowContext) restoredStateIssues(ctx context.Context) ([]string, error) {
+	var (
+		ids    = fctx.whiteboard.GetChild(ChildKeyIDs)
+		region = fctx.infra.Spec.Region
+		issues []string
+	)
+
+	if name := ids.Get(ObjectKeyVPC); name != nil {
+		vpc, err := fctx.computeClient.GetNetwork(ctx, *name)
+		if err != nil {
+			return nil, err
+		}
+		if vpc == nil {
+			issues = append(issues, fmt.Sprintf("VPC %s does not exist", *name))
+		}
+	}
+
+	for _, key := range []string{ObjectKeyNodeSubnet, ObjectKeyInternalSubnet, ObjectKeyServicesSubnet} {
+		name := ids.Get(key)
+		if name == nil {
+			continue
+		}
+
+		subnet, err := fctx.computeClient.GetSubnet(ctx, region, *name)
+		if err != nil {
+			return nil, err
+		}
+		if subnet == nil {
+			issues = append(issues, fmt.Sprintf("subnet %s does not exist", *name))
+			continue
+		}
+
+		if key == ObjectKeyNodeSubnet {
+			issues = append(issues, secondaryRangeIssues(subnet, ids.GetChild(ChildKeySecondaryRanges))...)
+		}
+	}
+
+	if name := ids.Get(ObjectKeyRouter); name != nil {
+		router, err := fctx.computeClient.GetRouter(ctx, region, *name)
+		if err != nil {
+			return nil, err
+		}
+		if router == nil {
+			issues = append(issues, fmt.Sprintf("Cloud Router %s does not exist", *name))
+		} else if natName := ids.Get(ObjectKeyNAT); natName != nil && !hasNAT(router, *natName) {
+			issues = append(issues, fmt.Sprintf("Cloud NAT %s does not exist in Cloud Router %s", *natName, *name))
+		}
+	}
+
+	natIPs := ids.GetChild(ChildKeyNATIPs)
+	for _, name := range natIPs.Keys() {
+		ip := natIPs.Get(name)
+		if ip == nil {
+			continue
+		}
+
+		address, err := fctx.computeClient.GetAddress(ctx, region, name)
+		if err != nil {
+			return nil, err
+		}
+		if address == nil {
+			issues = append(issues, fmt.Sprintf("NAT IP %s does not exist", name))
+		} else if address.Address != *ip {
+			issues = append(issues, fmt.Sprintf("NAT IP %s has address %s instead of %s", name, address.Address, *ip))
+		}
+	}
+
+	return issues, nil
+}
+
+func secondaryRangeIssues(subnet *compute.Subnetwork, recorded shared.Whiteboard) []string {
+	current := map[string]string{}
+	for _, r := range subnet.SecondaryIpRanges {
+		current[r.RangeName] = r.IpCidrRange
+	}
+
+	var issues []string
+	for _, name := range recorded.Keys() {
+		cidr := recorded.Get(name)
+		if cidr == nil {
+			continue
+		}
+
+		if current[name] == "" {
+			issues = append(issues, fmt.Sprintf("secondary range %s of subnet %s does not exist", name, subnet.Name))
+		} else if current[name] != *cidr {
+			issues = append(issues, fmt.Sprintf("secondary range %s of subnet %s has CIDR %s instead of %s", name, subnet.Name, current[name], *cidr))
+		}
+	}
+	return issues
+}
+
+func hasNAT(router *compute.Router, name string) bool {
+	for _, nat := range router.Nats {
+		if nat.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	gcpclientfake "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+var _ = Describe("State", func() {
+	const region = "europe-west1"
+
+	var (
+		ctx = context.TODO()
+
+		computeClient *gcpclientfake.ComputeClient
+		fctx          *FlowContext
+	)
+
+	BeforeEach(func() {
+		computeClient = gcpclientfake.NewComputeClient("project")
+		fctx = &FlowContext{
+			infra:         &extensionsv1alpha1.Infrastructure{Spec: extensionsv1alpha1.InfrastructureSpec{Region: region}},
+			whiteboard:    shared.NewWhiteboard(),
+			computeClient: computeClient,
+		}
+
+		_, err := computeClient.InsertNetwork(ctx, &compute.Network{Name: "shoot--foo--bar"})
+		Expect(err).NotTo(HaveOccurred())
+		subnet, err := computeClient.InsertSubnet(ctx, region, &compute.Subnetwork{
+			Name:              "shoot--foo--bar-nodes",
+			IpCidrRange:       "10.250.0.0/16",
+			SecondaryIpRanges: []*compute.SubnetworkSecondaryRange{{RangeName: "ipv4-pod-cidr", IpCidrRange: "100.96.0.0/11"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = computeClient.InsertRouter(ctx, region, &compute.Router{
+			Name: "shoot--foo--bar-cloud-router",
+			Nats: []*compute.RouterNat{{Name: "shoot--foo--bar-cloud-nat"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		address := &compute.Address{Name: "nat-ip", Address: "1.2.3.4"}
+		computeClient.AddAddress(region, address)
+
+		ids := fctx.whiteboard.GetChild(ChildKeyIDs)
+		ids.Set(ObjectKeyVPC, "shoot--foo--bar")
+		ids.Set(ObjectKeyNodeSubnet, subnet.Name)
+		fctx.recordSecondaryRanges(subnet)
+		ids.Set(ObjectKeyRouter, "shoot--foo--bar-cloud-router")
+		ids.Set(ObjectKeyNAT, "shoot--foo--bar-cloud-nat")
+		fctx.recordNATIPs([]*compute.Address{address})
+	})
+
+	It("should export the recorded identifiers as flat map", func() {
+		Expect(fctx.whiteboard.ExportAsFlatMap()).To(Equal(shared.FlatMap{
+			"ids/vpc":                            "shoot--foo--bar",
+			"ids/subnet-nodes":                   "shoot--foo--bar-nodes",
+			"ids/secondary-ranges/ipv4-pod-cidr": "100.96.0.0/11",
+			"ids/router":                         "shoot--foo--bar-cloud-router",
+			"ids/nat":                            "shoot--foo--bar-cloud-nat",
+			"ids/nat-ips/nat-ip":                 "1.2.3.4",
+		}))
+	})
+
+	It("should not record an event if all recorded resources exist", func() {
+		recorder := record.NewFakeRecorder(10)
+		fctx.recorder = events.NewRecorder(recorder, nil)
+
+		fctx.verifyRestoredState(ctx)
+
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should report missing and changed resources in a warning event", func() {
+		recorder := record.NewFakeRecorder(10)
+		fctx.recorder = events.NewRecorder(recorder, nil)
+
+		Expect(computeClient.DeleteNetwork(ctx, "shoot--foo--bar")).To(Succeed())
+		computeClient.AddAddress(region, &compute.Address{Name: "nat-ip", Address: "5.6.7.8"})
+		fctx.whiteboard.GetChild(ChildKeyIDs).GetChild(ChildKeySecondaryRanges).Set("ipv4-pod-cidr", "100.64.0.0/11")
+
+		fctx.verifyRestoredState(ctx)
+
+		var event string
+		Expect(recorder.Events).To(Receive(&event))
+		Expect(event).To(HavePrefix("Warning RestoredStateMismatch "))
+		Expect(event).To(ContainSubstring("VPC shoot--foo--bar does not exist"))
+		Expect(event).To(ContainSubstring("secondary range ipv4-pod-cidr of subnet shoot--foo--bar-nodes has CIDR 100.96.0.0/11 instead of 100.64.0.0/11"))
+		Expect(event).To(ContainSubstring("NAT IP nat-ip has address 5.6.7.8 instead of 1.2.3.4"))
+	})
+
+	It("should forget NAT IPs which are not used anymore", func() {
+		fctx.recordNATIPs(nil)
+
+		Expect(fctx.whiteboard.ExportAsFlatMap()).NotTo(HaveKey("ids/nat-ips/nat-ip"))
+	})
+
+	It("should record events for NAT IPs which were not stored yet", func() {
+		recorder := record.NewFakeRecorder(10)
+		fctx.recorder = events.NewRecorder(recorder, nil)
+
+		fctx.recordNATIPs([]*compute.Address{{Name: "nat-ip", Address: "1.2.3.4"}, {Name: "nat-ip-2", Address: "5.6.7.8"}})
+
+		Expect(recorder.Events).To(Receive(Equal(`Normal NATIPAllocated NAT IP "nat-ip-2" with address 5.6.7.8 allocated to Cloud NAT`)))
+		Expect(recorder.Events).NotTo(Receive())
+	})
+})
@@ -12,7 +12,6 @@ import (
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/terraformer"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -68,7 +67,7 @@ func (t *TerraformReconciler) Restore(ctx context.Context, infra *extensionsv1al
 // Reconcile reconciles infrastructure using Terraformer.
 func (t *TerraformReconciler) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
 	err := t.reconcile(ctx, infra, cluster, terraformer.StateConfigMapInitializerFunc(terraformer.CreateState))
-	return util.DetermineError(err, helper.KnownCodes)
+	return helper.DetermineError(err)
 }
 
 func (t *TerraformReconciler) reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster, initializer terraformer.StateConfigMapInitializer) error {
@@ -96,7 +95,12 @@ func (t *TerraformReconciler) reconcile(ctx context.Context, infra *extensionsv1
 		return err
 	}
 
-	terraformFiles, err := infrastructure.RenderTerraformerTemplate(infra, credentialsConfig, config, cluster.Shoot.Spec.Networking.Pods, createSA)
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	terraformFiles, err := infrastructure.RenderTerraformerTemplate(infra, credentialsConfig, config, cluster.Shoot.Spec.Networking.Pods, createSA, helper.IsAliasIPModeEnabled(cpConfig))
 	if err != nil {
 		return err
 	}
@@ -117,7 +121,7 @@ func (t *TerraformReconciler) reconcile(ctx context.Context, infra *extensionsv1
 
 // Delete deletes the infrastructure using Terraformer.
 func (t *TerraformReconciler) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, c *extensions.Cluster) error {
-	return util.DetermineError(t.delete(ctx, infra, c), helper.KnownCodes)
+	return helper.DetermineError(t.delete(ctx, infra, c))
 }
 
 func (t *TerraformReconciler) delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, _ *extensions.Cluster) error {
@@ -154,7 +158,7 @@ func (t *TerraformReconciler) delete(ctx context.Context, infra *extensionsv1alp
 
 	gcpClient, err := gcpclient.New().Compute(ctx, t.client, infra.Spec.SecretRef)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	configExists, err := tf.ConfigExists(ctx)
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instrumentation
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
+	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+)
+
+type infrastructureActuator struct {
+	actuator infrastructure.Actuator
+}
+
+// NewInfrastructureActuator returns an infrastructure.Actuator which instruments the operations of the given one.
+func NewInfrastructureActuator(actuator infrastructure.Actuator) infrastructure.Actuator {
+	return &infrastructureActuator{actuator: actuator}
+}
+
+func (a *infrastructureActuator) Reconcile(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "infrastructure", operationReconcile, func(ctx context.Context) error {
+		return a.actuator.Reconcile(ctx, log, infra, cluster)
+	})
+}
+
+func (a *infrastructureActuator) Delete(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "infrastructure", operationDelete, func(ctx context.Context) error {
+		return a.actuator.Delete(ctx, log, infra, cluster)
+	})
+}
+
+func (a *infrastructureActuator) ForceDelete(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "infrastructure", operationForceDelete, func(ctx context.Context) error {
+		return a.actuator.ForceDelete(ctx, log, infra, cluster)
+	})
+}
+
+func (a *infrastructureActuator) Restore(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "infrastructure", operationRestore, func(ctx context.Context) error {
+		return a.actuator.Restore(ctx, log, infra, cluster)
+	})
+}
+
+func (a *infrastructureActuator) Migrate(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "infrastructure", operationMigrate, func(ctx context.Context) error {
+		return a.actuator.Migrate(ctx, log, infra, cluster)
+	})
+}
+
+type workerActuator struct {
+	actuator worker.Actuator
+}
+
+// NewWorkerActuator returns a worker.Actuator which instruments the operations of the given one.
+func NewWorkerActuator(actuator worker.Actuator) worker.Actuator {
+	return &workerActuator{actuator: actuator}
+}
+
+func (a *workerActuator) Reconcile(ctx context.Context, log logr.Logger, w *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "worker", operationReconcile, func(ctx context.Context) error {
+		return a.actuator.Reconcile(ctx, log, w, cluster)
+	})
+}
+
+func (a *workerActuator) Delete(ctx context.Context, log logr.Logger, w *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "worker", operationDelete, func(ctx context.Context) error {
+		return a.actuator.Delete(ctx, log, w, cluster)
+	})
+}
+
+func (a *workerActuator) ForceDelete(ctx context.Context, log logr.Logger, w *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "worker", operationForceDelete, func(ctx context.Context) error {
+		return a.actuator.ForceDelete(ctx, log, w, cluster)
+	})
+}
+
+func (a *workerActuator) Restore(ctx context.Context, log logr.Logger, w *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "worker", operationRestore, func(ctx context.Context) error {
+		return a.actuator.Restore(ctx, log, w, cluster)
+	})
+}
+
+func (a *workerActuator) Migrate(ctx context.Context, log logr.Logger, w *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "worker", operationMigrate, func(ctx context.Context) error {
+		return a.actuator.Migrate(ctx, log, w, cluster)
+	})
+}
+
+type controlPlaneActuator struct {
+	actuator controlplane.Actuator
+}
+
+// NewControlPlaneActuator returns a controlplane.Actuator which instruments the operations of the given one.
+func NewControlPlaneActuator(actuator controlplane.Actuator) controlplane.Actuator {
+	return &controlPlaneActuator{actuator: actuator}
+}
+
+func (a *controlPlaneActuator) Reconcile(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	var requeue bool
+	err := observe(ctx, "controlplane", operationReconcile, func(ctx context.Context) error {
+		var err error
+		requeue, err = a.actuator.Reconcile(ctx, log, cp, cluster)
+		return err
+	})
+	return requeue, err
+}
+
+func (a *controlPlaneActuator) Delete(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "controlplane", operationDelete, func(ctx context.Context) error {
+		return a.actuator.Delete(ctx, log, cp, cluster)
+	})
+}
+
+func (a *controlPlaneActuator) ForceDelete(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "controlplane", operationForceDelete, func(ctx context.Context) error {
+		return a.actuator.ForceDelete(ctx, log, cp, cluster)
+	})
+}
+
+func (a *controlPlaneActuator) Restore(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	var requeue bool
+	err := observe(ctx, "controlplane", operationRestore, func(ctx context.Context) error {
+		var err error
+		requeue, err = a.actuator.Restore(ctx, log, cp, cluster)
+		return err
+	})
+	return requeue, err
+}
+
+func (a *controlPlaneActuator) Migrate(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	return observe(ctx, "controlplane", operationMigrate, func(ctx context.Context) error {
+		return a.actuator.Migrate(ctx, log, cp, cluster)
+	})
+}
+
+type backupBucketActuator struct {
+	actuator backupbucket.Actuator
+}
+
+// NewBackupBucketActuator returns a backupbucket.Actuator which instruments the operations of the given one.
+func NewBackupBucketActuator(actuator backupbucket.Actuator) backupbucket.Actuator {
+	return &backupBucketActuator{actuator: actuator}
+}
+
+func (a *backupBucketActuator) Reconcile(ctx context.Context, log logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
+	return observe(ctx, "backupbucket", operationReconcile, func(ctx context.Context) error {
+		return a.actuator.Reconcile(ctx, log, bb)
+	})
+}
+
+func (a *backupBucketActuator) Delete(ctx context.Context, log logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
+	return observe(ctx, "backupbucket", operationDelete, func(ctx context.Context) error {
+		return a.actuator.Delete(ctx, log, bb)
+	})
+}
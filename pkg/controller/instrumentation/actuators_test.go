@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instrumentation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
+	mockcontrolplane "github.com/gardener/gardener/extensions/pkg/controller/controlplane/mock"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Actuators", func() {
+	var (
+		ctx = context.TODO()
+		log = logr.Discard()
+
+		ctrl     *gomock.Controller
+		mock     *mockcontrolplane.MockActuator
+		actuator controlplane.Actuator
+		cp       *extensionsv1alpha1.ControlPlane
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mock = mockcontrolplane.NewMockActuator(ctrl)
+		actuator = NewControlPlaneActuator(mock)
+
+		operationDuration.Reset()
+		operationFailures.Reset()
+		operationAPIRequests.Reset()
+
+		cp = &extensionsv1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"}}
+	})
+
+	It("should record a successful operation and pass its result through", func() {
+		mock.EXPECT().Reconcile(gomock.Any(), log, cp, nil).Return(true, nil)
+
+		requeue, err := actuator.Reconcile(ctx, log, cp, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requeue).To(BeTrue())
+
+		Expect(testutil.CollectAndCount(operationDuration)).To(Equal(1))
+		Expect(testutil.CollectAndCount(operationAPIRequests)).To(Equal(1))
+		Expect(testutil.ToFloat64(operationFailures.WithLabelValues("controlplane", operationReconcile))).To(BeZero())
+	})
+
+	It("should record a failed operation", func() {
+		mock.EXPECT().Delete(gomock.Any(), log, cp, nil).Return(errors.New("fake"))
+
+		Expect(actuator.Delete(ctx, log, cp, nil)).To(MatchError("fake"))
+
+		Expect(testutil.ToFloat64(operationFailures.WithLabelValues("controlplane", operationDelete))).To(Equal(float64(1)))
+	})
+})
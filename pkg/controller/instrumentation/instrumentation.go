@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package instrumentation records metrics of the operations of the actuators of the extension, so that slow
+// or failing phases of a shoot reconciliation can be attributed to a controller and to the GCP API requests it sent.
+package instrumentation
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+const (
+	operationReconcile   = "reconcile"
+	operationDelete      = "delete"
+	operationForceDelete = "force-delete"
+	operationMigrate     = "migrate"
+	operationRestore     = "restore"
+)
+
+var (
+	// operationDuration is the duration of the operations of the actuators, partitioned by controller and operation.
+	operationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "gcp_extension_actuator_operation_duration_seconds",
+		Help:    "Duration of the operations of the actuators in seconds, partitioned by controller and operation.",
+		Buckets: []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600, 1200},
+	}, []string{"controller", "operation"})
+
+	// operationFailures is the number of failed operations of the actuators, partitioned by controller and operation.
+	operationFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gcp_extension_actuator_operation_failures_total",
+		Help: "Total number of failed operations of the actuators, partitioned by controller and operation.",
+	}, []string{"controller", "operation"})
+
+	// operationAPIRequests is the number of requests to the GCP APIs sent by a single operation of an actuator,
+	// partitioned by controller and operation.
+	operationAPIRequests = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "gcp_extension_actuator_operation_gcp_api_requests",
+		Help:    "Number of requests to the GCP APIs sent by a single operation of an actuator, partitioned by controller and operation.",
+		Buckets: []float64{0, 1, 5, 10, 25, 50, 100, 250, 500},
+	}, []string{"controller", "operation"})
+)
+
+func init() {
+	// The metrics are served by the metrics endpoint of the controller manager.
+	metrics.Registry.MustRegister(operationDuration, operationFailures, operationAPIRequests)
+}
+
+// observe runs the given operation of the actuator of the given controller, and records its duration, its failure and
+// the number of GCP API requests it sent.
+func observe(ctx context.Context, controller, operation string, fn func(context.Context) error) error {
+	ctx, requests := gcpclient.WithRequestCounter(ctx)
+	start := time.Now()
+	err := fn(ctx)
+
+	operationDuration.WithLabelValues(controller, operation).Observe(time.Since(start).Seconds())
+	operationAPIRequests.WithLabelValues(controller, operation).Observe(float64(requests()))
+	if err != nil {
+		operationFailures.WithLabelValues(controller, operation).Inc()
+	}
+	return err
+}
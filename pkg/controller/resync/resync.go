@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resync
+
+import (
+	"context"
+	"time"
+
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Args are the arguments for the resync of the extension resources of a controller.
+type Args struct {
+	// Reader is used to read the extension resources, usually the cached client of the manager.
+	Reader client.Reader
+	// NewObjectFunc returns a new object of the kind of the extension resources.
+	NewObjectFunc func() client.Object
+	// NewObjectListFunc returns a new list of the kind of the extension resources.
+	NewObjectListFunc func() client.ObjectList
+	// Type is the type of the extension resources.
+	Type string
+	// ExtensionClass is the class of the extension resources.
+	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// Period is the period after the last successful reconciliation after which the extension resources are resynced.
+	Period time.Duration
+}
+
+// ControllerOptions returns the given controller options with a queue which requeues the extension resources after the
+// resync period once they were reconciled successfully. The extension controllers only reconcile their resources if
+// they are changed or annotated with the operation annotation, hence drift in GCP is only corrected once the resource
+// is resynced. The options are returned unchanged if the period is not positive.
+func ControllerOptions(options controller.Options, args Args) controller.Options {
+	if args.Period <= 0 {
+		return options
+	}
+
+	options.NewQueue = func(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+		q := &queue{
+			TypedRateLimitingInterface: workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
+				Name: controllerName,
+			}),
+			args:       args,
+			predicates: extensionspredicate.AddTypeAndClassPredicates(nil, args.ExtensionClass, args.Type),
+			clock:      clock.RealClock{},
+		}
+		q.addExisting(context.Background(), logf.Log.WithName(controllerName+"-resync"))
+		return q
+	}
+	return options
+}
+
+// queue is a rate limiting queue which requeues requests after the resync period once they were reconciled
+// successfully.
+type queue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+
+	args       Args
+	predicates []predicate.Predicate
+	clock      clock.Clock
+}
+
+// addExisting schedules the resync of the existing extension resources which were reconciled successfully. The queue
+// is created when the controller is started, i.e. once the cache was synced. The create events of these resources are
+// filtered by the predicates of the controller, hence they would otherwise only be resynced after their next change.
+func (q *queue) addExisting(ctx context.Context, log logr.Logger) {
+	list := q.args.NewObjectListFunc()
+	if err := q.args.Reader.List(ctx, list); err != nil {
+		log.Error(err, "Failed to list resources, they are resynced after their next reconciliation")
+		return
+	}
+
+	if err := meta.EachListItem(list, func(o runtime.Object) error {
+		obj, ok := o.(extensionsv1alpha1.Object)
+		if !ok || !q.matches(obj) {
+			return nil
+		}
+
+		// Resources whose last operation did not succeed are reconciled by the controller on startup anyway and
+		// migrated resources must not be reconciled anymore.
+		lastOperation := obj.GetExtensionStatus().GetLastOperation()
+		if lastOperation == nil ||
+			lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded ||
+			lastOperation.Type == gardencorev1beta1.LastOperationTypeMigrate {
+			return nil
+		}
+
+		q.AddAfter(reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}, lastOperation.LastUpdateTime.Add(q.args.Period).Sub(q.clock.Now()))
+		return nil
+	}); err != nil {
+		log.Error(err, "Failed to schedule resync of resources")
+	}
+}
+
+func (q *queue) matches(obj client.Object) bool {
+	for _, p := range q.predicates {
+		if !p.Generic(event.GenericEvent{Object: obj}) {
+			return false
+		}
+	}
+	return true
+}
+
+// Forget is called by the controller once a request was reconciled successfully. The request is requeued after the
+// resync period unless the resource does not exist anymore. Earlier requeues of the request, e.g. due to a
+// `RequeueAfter` of the reconciler or a change of the resource, take precedence.
+func (q *queue) Forget(request reconcile.Request) {
+	q.TypedRateLimitingInterface.Forget(request)
+
+	if err := q.args.Reader.Get(context.Background(), request.NamespacedName, q.args.NewObjectFunc()); err != nil {
+		// Deleted resources must not be resynced anymore. Other errors can only be caused by the cache, in this case
+		// the resource is resynced after its next reconciliation.
+		return
+	}
+
+	q.AddAfter(request, q.args.Period)
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resync
+
+import (
+	"context"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Resync", func() {
+	var (
+		ctx = context.TODO()
+
+		c       client.Client
+		args    Args
+		infra   *extensionsv1alpha1.Infrastructure
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "gcp"},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(infra)}
+	})
+
+	JustBeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(infra).Build()
+
+		args = Args{
+			Reader:            c,
+			NewObjectFunc:     func() client.Object { return &extensionsv1alpha1.Infrastructure{} },
+			NewObjectListFunc: func() client.ObjectList { return &extensionsv1alpha1.InfrastructureList{} },
+			Type:              "gcp",
+			Period:            time.Hour,
+		}
+	})
+
+	newQueue := func() workqueue.TypedRateLimitingInterface[reconcile.Request] {
+		options := ControllerOptions(controller.Options{MaxConcurrentReconciles: 5}, args)
+		ExpectWithOffset(1, options.MaxConcurrentReconciles).To(Equal(5))
+		ExpectWithOffset(1, options.NewQueue).NotTo(BeNil())
+
+		q := options.NewQueue("infrastructure", workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+		DeferCleanup(q.ShutDown)
+		return q
+	}
+
+	It("should not change the options if the period is not positive", func() {
+		args.Period = 0
+
+		Expect(ControllerOptions(controller.Options{MaxConcurrentReconciles: 5}, args)).To(Equal(controller.Options{MaxConcurrentReconciles: 5}))
+	})
+
+	Describe("#Forget", func() {
+		It("should requeue the request after the period once it was reconciled successfully", func() {
+			args.Period = 100 * time.Millisecond
+			q := newQueue()
+
+			q.Forget(request)
+			Expect(q.Len()).To(BeZero())
+
+			Eventually(q.Len).Should(Equal(1))
+			item, _ := q.Get()
+			Expect(item).To(Equal(request))
+		})
+
+		It("should not requeue the request if the resource does not exist anymore", func() {
+			args.Period = 10 * time.Millisecond
+			q := newQueue()
+			Expect(c.Delete(ctx, infra)).To(Succeed())
+
+			q.Forget(request)
+
+			Consistently(q.Len, 200*time.Millisecond).Should(BeZero())
+		})
+
+		It("should keep earlier requeues of the request", func() {
+			q := newQueue()
+
+			q.AddAfter(request, 10*time.Millisecond)
+			q.Forget(request)
+
+			Eventually(q.Len).Should(Equal(1))
+		})
+	})
+
+	Describe("existing resources", func() {
+		BeforeEach(func() {
+			infra.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:           gardencorev1beta1.LastOperationTypeReconcile,
+				State:          gardencorev1beta1.LastOperationStateSucceeded,
+				LastUpdateTime: metav1.NewTime(time.Now().Add(-time.Hour + 100*time.Millisecond)),
+			}
+		})
+
+		It("should resync the resource once the period has elapsed since its last reconciliation", func() {
+			q := newQueue()
+			Expect(q.Len()).To(BeZero())
+
+			Eventually(q.Len).Should(Equal(1))
+			item, _ := q.Get()
+			Expect(item).To(Equal(request))
+		})
+
+		It("should not resync resources of other types", func() {
+			infra.Spec.Type = "aws"
+
+			q := newQueue()
+
+			Consistently(q.Len, 300*time.Millisecond).Should(BeZero())
+		})
+
+		It("should not resync resources of other classes", func() {
+			infra.Spec.Class = ptr.To(extensionsv1alpha1.ExtensionClassGarden)
+
+			q := newQueue()
+
+			Consistently(q.Len, 300*time.Millisecond).Should(BeZero())
+		})
+
+		It("should not resync failed resources", func() {
+			infra.Status.LastOperation.State = gardencorev1beta1.LastOperationStateError
+
+			q := newQueue()
+
+			Consistently(q.Len, 300*time.Millisecond).Should(BeZero())
+		})
+
+		It("should not resync migrated resources", func() {
+			infra.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeMigrate
+
+			q := newQueue()
+
+			Consistently(q.Len, 300*time.Millisecond).Should(BeZero())
+		})
+	})
+})
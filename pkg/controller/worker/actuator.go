@@ -10,8 +10,6 @@ import (
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
-	"github.com/gardener/gardener/extensions/pkg/util"
-	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardener "github.com/gardener/gardener/pkg/client/kubernetes"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -24,6 +22,8 @@ import (
 
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
 type delegateFactory struct {
@@ -31,6 +31,8 @@ type delegateFactory struct {
 	seedClient   client.Client
 	restConfig   *rest.Config
 	scheme       *runtime.Scheme
+	gcpClient    gcpclient.Factory
+	recorder     *events.Recorder
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
@@ -40,15 +42,15 @@ func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster) worker.Actu
 		seedClient:   mgr.GetClient(),
 		restConfig:   mgr.GetConfig(),
 		scheme:       mgr.GetScheme(),
+		gcpClient:    gcpclient.New(),
+		recorder:     events.NewRecorderForClusters(worker.ControllerName, mgr, gardenCluster),
 	}
 
 	return genericactuator.NewActuator(
 		mgr,
 		gardenCluster,
 		WorkerDelegate,
-		func(err error) []gardencorev1beta1.ErrorCode {
-			return util.DetermineErrorCodes(err, helper.KnownCodes)
-		},
+		helper.DetermineErrorCodes,
 	)
 }
 
@@ -71,6 +73,8 @@ func (d *delegateFactory) WorkerDelegate(_ context.Context, worker *extensionsv1
 	return NewWorkerDelegate(
 		d.seedClient,
 		d.scheme,
+		d.gcpClient,
+		d.recorder,
 
 		seedChartApplier,
 		serverVersion.GitVersion,
@@ -86,6 +90,9 @@ type WorkerDelegate struct {
 	decoder runtime.Decoder
 	scheme  *runtime.Scheme
 
+	gcpClient gcpclient.Factory
+	recorder  *events.Recorder
+
 	seedChartApplier gardener.ChartApplier
 	serverVersion    string
 
@@ -102,6 +109,8 @@ type WorkerDelegate struct {
 func NewWorkerDelegate(
 	client client.Client,
 	scheme *runtime.Scheme,
+	gcpClient gcpclient.Factory,
+	recorder *events.Recorder,
 
 	seedChartApplier gardener.ChartApplier,
 	serverVersion string,
@@ -118,6 +127,9 @@ func NewWorkerDelegate(
 		scheme:  scheme,
 		decoder: serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder(),
 
+		gcpClient: gcpClient,
+		recorder:  recorder,
+
 		seedChartApplier: seedChartApplier,
 		serverVersion:    serverVersion,
 
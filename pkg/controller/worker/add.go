@@ -6,16 +6,20 @@ package worker
 
 import (
 	"context"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	machinescheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
 	apiextensionsscheme "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/scheme"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/instrumentation"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/resync"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
@@ -34,6 +38,8 @@ type AddOptions struct {
 	IgnoreOperationAnnotation bool
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// ResyncPeriod is the period after which the resources are reconciled again even if they did not change.
+	ResyncPeriod time.Duration
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -47,9 +53,18 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 		return err
 	}
 
+	controllerOptions := resync.ControllerOptions(opts.Controller, resync.Args{
+		Reader:            mgr.GetClient(),
+		NewObjectFunc:     func() client.Object { return &extensionsv1alpha1.Worker{} },
+		NewObjectListFunc: func() client.ObjectList { return &extensionsv1alpha1.WorkerList{} },
+		Type:              gcp.Type,
+		ExtensionClass:    opts.ExtensionClass,
+		Period:            opts.ResyncPeriod,
+	})
+
 	return worker.Add(mgr, worker.AddArgs{
-		Actuator:          NewActuator(mgr, opts.GardenCluster),
-		ControllerOptions: opts.Controller,
+		Actuator:          instrumentation.NewWorkerActuator(NewActuator(mgr, opts.GardenCluster)),
+		ControllerOptions: controllerOptions,
 		Predicates:        worker.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              gcp.Type,
 		ExtensionClass:    opts.ExtensionClass,
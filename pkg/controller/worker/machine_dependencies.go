@@ -19,8 +19,8 @@ func (w *WorkerDelegate) CleanupMachineDependencies(_ context.Context) error {
 }
 
 // PreReconcileHook implements genericactuator.WorkerDelegate.
-func (w *WorkerDelegate) PreReconcileHook(_ context.Context) error {
-	return nil
+func (w *WorkerDelegate) PreReconcileHook(ctx context.Context) error {
+	return w.checkMachineTypeChanges(ctx)
 }
 
 // PostReconcileHook implements genericactuator.WorkerDelegate.
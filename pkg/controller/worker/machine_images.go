@@ -9,10 +9,13 @@ import (
 	"fmt"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/utils/ptr"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
 )
 
 // UpdateMachineImagesStatus updates the machine image status
@@ -30,6 +33,7 @@ func (w *WorkerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 		return fmt.Errorf("unable to decode the worker provider status: %w", err)
 	}
 
+	w.recordResolvedMachineImages(workerStatus.MachineImages)
 	workerStatus.MachineImages = w.machineImages
 	if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
 		return fmt.Errorf("unable to update worker provider status: %w", err)
@@ -38,6 +42,22 @@ func (w *WorkerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 	return nil
 }
 
+// recordResolvedMachineImages records an event for every machine image of the worker which is not contained in the
+// given machine images of the worker status yet.
+func (w *WorkerDelegate) recordResolvedMachineImages(statusMachineImages []api.MachineImage) {
+	var shoot *gardencorev1beta1.Shoot
+	if w.cluster != nil {
+		shoot = w.cluster.Shoot
+	}
+
+	for _, machineImage := range w.machineImages {
+		if _, err := helper.FindMachineImage(statusMachineImages, machineImage.Name, machineImage.Version, machineImage.Architecture); err != nil {
+			w.recorder.Eventf(w.worker, shoot, events.ReasonMachineImageResolved, "Machine image %s %s (%s) resolved to %s",
+				machineImage.Name, machineImage.Version, ptr.Deref(machineImage.Architecture, ""), machineImage.Image)
+		}
+	}
+}
+
 func (w *WorkerDelegate) findMachineImage(name, version string, architecture *string) (string, error) {
 	machineImage, err := helper.FindImageFromCloudProfile(w.cloudProfileConfig, name, version, architecture)
 	if err == nil {
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// quotaMetricCPUs is the metric of the regional CPU quota.
+const quotaMetricCPUs = "CPUS"
+
+// checkMachineTypeChanges checks the machine type changes of worker pools hosting system components before the machine
+// classes and machine deployments are updated. The reconciliation fails if the new machine type is not available in all
+// zones of a pool or if the regional CPU quota does not suffice for the surge machines, so that the existing machines
+// are not replaced by machines which cannot be provisioned.
+func (w *WorkerDelegate) checkMachineTypeChanges(ctx context.Context) error {
+	changedPools, err := w.poolsWithMachineTypeChange(ctx)
+	if err != nil {
+		return fmt.Errorf("could not determine worker pools with machine type changes: %w", err)
+	}
+	if len(changedPools) == 0 {
+		return nil
+	}
+
+	computeClient, err := w.gcpClient.Compute(ctx, w.client, w.worker.Spec.SecretRef)
+	if err != nil {
+		return fmt.Errorf("could not create compute client: %w", err)
+	}
+
+	var requiredCPUs int64
+	for _, pool := range changedPools {
+		if len(pool.Zones) > math.MaxInt32 {
+			return fmt.Errorf("worker pool %q has too many zones", pool.Name)
+		}
+		zoneLen := int32(len(pool.Zones))
+
+		for zoneIdx := int32(0); zoneIdx < zoneLen; zoneIdx++ {
+			zone := pool.Zones[zoneIdx]
+			machineType, err := computeClient.GetMachineType(ctx, zone, pool.MachineType)
+			if err != nil {
+				return fmt.Errorf("could not get machine type %q in zone %q: %w", pool.MachineType, zone, err)
+			}
+			if machineType == nil {
+				return v1beta1helper.NewErrorWithCodes(fmt.Errorf("machine type %q of worker pool %q is not available in zone %q", pool.MachineType, pool.Name, zone), gardencorev1beta1.ErrorConfigurationProblem)
+			}
+
+			zoneMaximum := worker.DistributeOverZones(zoneIdx, pool.Maximum, zoneLen)
+			zoneMaxSurge := worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxSurge, zoneLen, pool.Maximum)
+			surge, err := intstr.GetScaledValueFromIntOrPercent(&zoneMaxSurge, int(zoneMaximum), true)
+			if err != nil {
+				return fmt.Errorf("could not determine max surge of worker pool %q: %w", pool.Name, err)
+			}
+			requiredCPUs += machineType.GuestCpus * int64(surge)
+		}
+	}
+
+	region, err := computeClient.GetRegion(ctx, w.worker.Spec.Region)
+	if err != nil {
+		return fmt.Errorf("could not get region %q: %w", w.worker.Spec.Region, err)
+	}
+	for _, quota := range region.Quotas {
+		if quota.Metric != quotaMetricCPUs {
+			continue
+		}
+		if available := quota.Limit - quota.Usage; available < float64(requiredCPUs) {
+			return v1beta1helper.NewErrorWithCodes(fmt.Errorf("quota %s of region %q does not suffice: changing the machine types requires %d CPUs but only %.0f are available", quotaMetricCPUs, w.worker.Spec.Region, requiredCPUs, available), gardencorev1beta1.ErrorInfraQuotaExceeded)
+		}
+	}
+
+	return nil
+}
+
+// poolsWithMachineTypeChange returns the existing worker pools hosting system components whose machine type changes.
+func (w *WorkerDelegate) poolsWithMachineTypeChange(ctx context.Context) ([]extensionsv1alpha1.WorkerPool, error) {
+	var changedPools []extensionsv1alpha1.WorkerPool
+	for _, pool := range w.worker.Spec.Pools {
+		if !w.systemComponentsAllowed(pool.Name) {
+			continue
+		}
+
+		currentMachineTypes, err := w.currentMachineTypes(ctx, pool)
+		if err != nil {
+			return nil, err
+		}
+		// New pools and pools without a machine type change are not relevant.
+		if currentMachineTypes.Len() == 0 || currentMachineTypes.Has(pool.MachineType) {
+			continue
+		}
+		changedPools = append(changedPools, pool)
+	}
+	return changedPools, nil
+}
+
+// systemComponentsAllowed checks whether the worker pool with the given name hosts system components.
+func (w *WorkerDelegate) systemComponentsAllowed(poolName string) bool {
+	for _, shootWorker := range w.cluster.Shoot.Spec.Provider.Workers {
+		if shootWorker.Name == poolName {
+			return v1beta1helper.SystemComponentsAllowed(&shootWorker)
+		}
+	}
+	return false
+}
+
+// currentMachineTypes returns the machine types of the machine classes which are currently referenced by the machine
+// deployments of the given worker pool.
+func (w *WorkerDelegate) currentMachineTypes(ctx context.Context, pool extensionsv1alpha1.WorkerPool) (sets.Set[string], error) {
+	machineTypes := sets.New[string]()
+
+	for zoneIndex := range pool.Zones {
+		machineDeployment := &machinev1alpha1.MachineDeployment{}
+		if err := w.client.Get(ctx, client.ObjectKey{Namespace: w.worker.Namespace, Name: fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)}, machineDeployment); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		machineClass := &machinev1alpha1.MachineClass{}
+		if err := w.client.Get(ctx, client.ObjectKey{Namespace: w.worker.Namespace, Name: machineDeployment.Spec.Template.Spec.Class.Name}, machineClass); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		providerSpec := struct {
+			MachineType string `json:"machineType"`
+		}{}
+		if err := json.Unmarshal(machineClass.ProviderSpec.Raw, &providerSpec); err != nil {
+			return nil, fmt.Errorf("could not decode provider spec of machine class %q: %w", machineClass.Name, err)
+		}
+		if len(providerSpec.MachineType) > 0 {
+			machineTypes.Insert(providerSpec.MachineType)
+		}
+	}
+
+	return machineTypes, nil
+}
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+	"errors"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	genericworkeractuator "github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("MachineTypes", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		region    = "europe-west1"
+		zone1     = "europe-west1-b"
+		zone2     = "europe-west1-c"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl          *gomock.Controller
+		c             *mockclient.MockClient
+		factory       *mockgcpclient.MockFactory
+		computeClient *mockgcpclient.MockComputeClient
+
+		secretRef corev1.SecretReference
+		w         *extensionsv1alpha1.Worker
+		cluster   *extensionscontroller.Cluster
+
+		workerDelegate genericworkeractuator.WorkerDelegate
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		c = mockclient.NewMockClient(ctrl)
+		factory = mockgcpclient.NewMockFactory(ctrl)
+		computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+
+		scheme := runtime.NewScheme()
+		Expect(api.AddToScheme(scheme)).To(Succeed())
+
+		secretRef = corev1.SecretReference{Namespace: namespace, Name: "cloudprovider"}
+		w = &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "worker"},
+			Spec: extensionsv1alpha1.WorkerSpec{
+				Region:    region,
+				SecretRef: secretRef,
+				Pools: []extensionsv1alpha1.WorkerPool{{
+					Name:        "pool",
+					MachineType: "n2-standard-4",
+					Maximum:     4,
+					MaxSurge:    intstr.FromInt32(2),
+					Zones:       []string{zone1, zone2},
+				}},
+			},
+		}
+		cluster = &extensionscontroller.Cluster{
+			Shoot: &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{{Name: "pool"}},
+					},
+				},
+			},
+		}
+
+		var err error
+		workerDelegate, err = NewWorkerDelegate(c, scheme, factory, nil, nil, "", w, cluster)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectCurrentMachineType := func(zoneIndex, machineType string) {
+		deploymentName := namespace + "-pool-z" + zoneIndex
+		c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeployment{})).DoAndReturn(
+			func(_ context.Context, _ client.ObjectKey, obj *machinev1alpha1.MachineDeployment, _ ...client.GetOption) error {
+				obj.Spec.Template.Spec.Class.Name = deploymentName + "-hash"
+				return nil
+			})
+		c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName + "-hash"}, gomock.AssignableToTypeOf(&machinev1alpha1.MachineClass{})).DoAndReturn(
+			func(_ context.Context, _ client.ObjectKey, obj *machinev1alpha1.MachineClass, _ ...client.GetOption) error {
+				obj.ProviderSpec = runtime.RawExtension{Raw: []byte(`{"machineType":"` + machineType + `"}`)}
+				return nil
+			})
+	}
+
+	expectRegionQuota := func(limit, usage float64) {
+		computeClient.EXPECT().GetRegion(ctx, region).Return(&compute.Region{
+			Quotas: []*compute.Quota{
+				{Metric: "INSTANCES", Limit: 100},
+				{Metric: "CPUS", Limit: limit, Usage: usage},
+			},
+		}, nil)
+	}
+
+	Describe("#PreReconcileHook", func() {
+		It("should not check new worker pools", func() {
+			c.EXPECT().Get(ctx, gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeployment{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")).Times(2)
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should not check worker pools whose machine type change is completed", func() {
+			expectCurrentMachineType("1", "n2-standard-4")
+			expectCurrentMachineType("2", "n2-standard-4")
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should not check worker pools which do not host system components", func() {
+			cluster.Shoot.Spec.Provider.Workers[0].SystemComponents = &gardencorev1beta1.WorkerSystemComponents{Allow: false}
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should succeed if the machine type change is feasible", func() {
+			expectCurrentMachineType("1", "n1-standard-2")
+			expectCurrentMachineType("2", "n1-standard-2")
+			factory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+			computeClient.EXPECT().GetMachineType(ctx, zone1, "n2-standard-4").Return(&compute.MachineType{GuestCpus: 4}, nil)
+			computeClient.EXPECT().GetMachineType(ctx, zone2, "n2-standard-4").Return(&compute.MachineType{GuestCpus: 4}, nil)
+			expectRegionQuota(24, 16)
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should fail if the new machine type is not available in a zone", func() {
+			expectCurrentMachineType("1", "n1-standard-2")
+			expectCurrentMachineType("2", "n1-standard-2")
+			factory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+			computeClient.EXPECT().GetMachineType(ctx, zone1, "n2-standard-4").Return(&compute.MachineType{GuestCpus: 4}, nil)
+			computeClient.EXPECT().GetMachineType(ctx, zone2, "n2-standard-4").Return(nil, nil)
+
+			err := workerDelegate.PreReconcileHook(ctx)
+			Expect(err).To(MatchError(ContainSubstring("is not available in zone")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+
+		It("should fail if the CPU quota does not suffice for the surge machines", func() {
+			expectCurrentMachineType("1", "n1-standard-2")
+			expectCurrentMachineType("2", "n1-standard-2")
+			factory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+			computeClient.EXPECT().GetMachineType(ctx, zone1, "n2-standard-4").Return(&compute.MachineType{GuestCpus: 4}, nil)
+			computeClient.EXPECT().GetMachineType(ctx, zone2, "n2-standard-4").Return(&compute.MachineType{GuestCpus: 4}, nil)
+			expectRegionQuota(24, 20)
+
+			err := workerDelegate.PreReconcileHook(ctx)
+			Expect(err).To(MatchError(ContainSubstring("quota CPUS")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorInfraQuotaExceeded))
+		})
+
+		It("should fail if the machine type change cannot be checked", func() {
+			expectCurrentMachineType("1", "n1-standard-2")
+			expectCurrentMachineType("2", "n1-standard-2")
+			factory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+			computeClient.EXPECT().GetMachineType(ctx, zone1, "n2-standard-4").Return(nil, errors.New("fake"))
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(MatchError(ContainSubstring("fake")))
+		})
+	})
+})
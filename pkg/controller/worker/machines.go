@@ -52,6 +52,12 @@ const (
 	ResourceGPU v1.ResourceName = "gpu"
 	// VolumeTypeScratch is the gcp SCRATCH volume type
 	VolumeTypeScratch = "SCRATCH"
+	// LocalSSDInterfaceNVME is the NVMe interface of local SSDs.
+	LocalSSDInterfaceNVME = "NVME"
+	// OnHostMaintenanceMigrate is the host maintenance policy which live migrates the VM.
+	OnHostMaintenanceMigrate = "MIGRATE"
+	// OnHostMaintenanceTerminate is the host maintenance policy which terminates the VM.
+	OnHostMaintenanceTerminate = "TERMINATE"
 )
 
 var (
@@ -118,6 +124,18 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	cpConfig, err := gcpapihelper.ControlPlaneConfigFromCluster(w.cluster)
+	if err != nil {
+		return err
+	}
+
+	// The cloud-controller-manager uses the additional tags as target tags of the firewall rules of load balancers, hence
+	// the nodes need to carry them.
+	var firewallTargetTags []string
+	if cpConfig.LoadBalancer != nil {
+		firewallTargetTags = cpConfig.LoadBalancer.FirewallTargetTags
+	}
+
 	for _, pool := range w.worker.Spec.Pools {
 		zoneLen := int32(len(pool.Zones)) // #nosec: G115 - We check if pool zones exceeds max_int32.
 
@@ -128,7 +146,7 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 			}
 		}
 
-		workerPoolHash, err := w.generateWorkerPoolHash(pool, *workerConfig)
+		workerPoolHash, err := w.generateWorkerPoolHash(pool, *workerConfig, firewallTargetTags)
 		if err != nil {
 			return err
 		}
@@ -225,11 +243,11 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 					"namespace": w.worker.Spec.SecretRef.Namespace,
 				},
 				"serviceAccounts": serviceAccounts,
-				"tags": []string{
+				"tags": append([]string{
 					w.worker.Namespace,
 					fmt.Sprintf("kubernetes-io-cluster-%s", w.worker.Namespace),
 					"kubernetes-io-role-node",
-				},
+				}, firewallTargetTags...),
 			}
 
 			if !gardencorev1beta1.IsIPv4SingleStack(infrastructureStatus.Networks.IPFamilies) {
@@ -243,6 +261,30 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 						"subnetworkRangeName": infraflow.DefaultSecondarySubnetName,
 					},
 				}
+			} else if gcpapihelper.IsAliasIPModeEnabled(cpConfig) {
+				machineClassSpec["networkInterfaces"] = []map[string]interface{}{
+					{
+						"subnetwork":          nodesSubnet.Name,
+						"disableExternalIP":   true,
+						"stackType":           w.getStackType(),
+						"ipCidrRange":         ipCidrRange,
+						"subnetworkRangeName": infraflow.DefaultSecondarySubnetName,
+					},
+				}
+			}
+
+			if len(workerConfig.AdditionalNetworkInterfaces) > 0 {
+				machineClassSpec["networkInterfaces"] = append(machineClassSpec["networkInterfaces"].([]map[string]interface{}), additionalNetworkInterfaces(workerConfig.AdditionalNetworkInterfaces)...)
+				if routes := additionalNetworkRoutes(workerConfig.AdditionalNetworkInterfaces); len(routes) > 0 {
+					machineClassSpec["metadata"] = append(machineClassSpec["metadata"].([]map[string]string), map[string]string{
+						"key":   gcp.AdditionalNetworkRoutesMetadataKey,
+						"value": routes,
+					})
+				}
+			}
+
+			if bootstrap := workerConfig.NodeBootstrap; bootstrap != nil {
+				machineClassSpec["metadata"] = append(machineClassSpec["metadata"].([]map[string]string), nodeBootstrapMetadata(bootstrap)...)
 			}
 
 			var (
@@ -313,7 +355,7 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 				}
 			}
 
-			setSchedulingPolicy(machineClassSpec, isLiveMigrationAllowed)
+			setSchedulingPolicy(machineClassSpec, isLiveMigrationAllowed, workerConfig.Scheduling)
 			machineClasses = append(machineClasses, machineClassSpec)
 		}
 	}
@@ -325,7 +367,7 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 	return nil
 }
 
-func (w *WorkerDelegate) generateWorkerPoolHash(pool v1alpha1.WorkerPool, workerConfig apisgcp.WorkerConfig) (string, error) {
+func (w *WorkerDelegate) generateWorkerPoolHash(pool v1alpha1.WorkerPool, workerConfig apisgcp.WorkerConfig, firewallTargetTags []string) (string, error) {
 	var additionalData []string
 
 	volumes := slices.Clone(pool.DataVolumes)
@@ -390,9 +432,73 @@ func (w *WorkerDelegate) generateWorkerPoolHash(pool v1alpha1.WorkerPool, worker
 		}
 	}
 
+	for _, nic := range workerConfig.AdditionalNetworkInterfaces {
+		additionalData = append(additionalData, nic.Network, nic.Subnetwork)
+		additionalData = append(additionalData, nic.Routes...)
+	}
+
+	// The scheduling options of existing machines are not updated, hence changes require new machines. They are only
+	// added if set, so that the hash of pools without scheduling options does not change.
+	if scheduling := workerConfig.Scheduling; scheduling != nil {
+		if onHostMaintenance := scheduling.OnHostMaintenance; onHostMaintenance != nil {
+			additionalData = append(additionalData, *onHostMaintenance)
+		}
+		if automaticRestart := scheduling.AutomaticRestart; automaticRestart != nil {
+			additionalData = append(additionalData, strconv.FormatBool(*automaticRestart))
+		}
+	}
+
+	// The settings of the bootstrap are passed via the instance metadata of existing machines which is not updated, hence
+	// changes require new machines.
+	if bootstrap := workerConfig.NodeBootstrap; bootstrap != nil {
+		for _, metadata := range nodeBootstrapMetadata(bootstrap) {
+			additionalData = append(additionalData, metadata["key"], metadata["value"])
+		}
+	}
+
+	// The network tags of existing machines are not updated, hence changes require new machines.
+	additionalData = append(additionalData, firewallTargetTags...)
+
 	return worker.WorkerPoolHash(pool, w.cluster, []string{}, additionalData)
 }
 
+func additionalNetworkInterfaces(nics []apisgcp.AdditionalNetworkInterface) []map[string]interface{} {
+	networkInterfaces := make([]map[string]interface{}, 0, len(nics))
+	for _, nic := range nics {
+		networkInterfaces = append(networkInterfaces, map[string]interface{}{
+			"network":           nic.Network,
+			"subnetwork":        nic.Subnetwork,
+			"disableExternalIP": true,
+		})
+	}
+	return networkInterfaces
+}
+
+// additionalNetworkRoutes returns the routes of the additional network interfaces in the format expected by the
+// route configuration on the nodes. The first additional interface has index 1 as index 0 is the interface attached
+// to the nodes subnet.
+func additionalNetworkRoutes(nics []apisgcp.AdditionalNetworkInterface) string {
+	var lines []string
+	for i, nic := range nics {
+		for _, route := range nic.Routes {
+			lines = append(lines, fmt.Sprintf("%d %s", i+1, route))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// nodeBootstrapMetadata returns the instance metadata which enables the GCP specific bootstrap of the nodes.
+func nodeBootstrapMetadata(bootstrap *apisgcp.NodeBootstrap) []map[string]string {
+	var metadata []map[string]string
+	if bootstrap.LocalSSD != nil {
+		metadata = append(metadata, map[string]string{"key": gcp.LocalSSDMountPathMetadataKey, "value": bootstrap.LocalSSD.MountPath})
+	}
+	if ptr.Deref(bootstrap.ConcealMetadataServer, false) {
+		metadata = append(metadata, map[string]string{"key": gcp.ConcealMetadataServerMetadataKey, "value": "true"})
+	}
+	return metadata
+}
+
 func createDiskSpecForVolume(volume *v1alpha1.Volume, image string, workerConfig *apisgcp.WorkerConfig, labels map[string]interface{}) (map[string]interface{}, error) {
 	return createDiskSpec(volume.Size, true, &image, volume.Type, workerConfig.Volume, nil, labels)
 }
@@ -495,19 +601,27 @@ func initializeCapacity(capacityList v1.ResourceList, gpuCount int32) v1.Resourc
 	return resultCapacity
 }
 
-func setSchedulingPolicy(machineClassSpec map[string]interface{}, isLiveMigrationAllowed bool) {
-	if isLiveMigrationAllowed {
-		machineClassSpec["scheduling"] = map[string]interface{}{
-			"automaticRestart":  true,
-			"onHostMaintenance": "MIGRATE",
-			"preemptible":       false,
-		}
-	} else {
-		machineClassSpec["scheduling"] = map[string]interface{}{
-			"automaticRestart":  true,
-			"onHostMaintenance": "TERMINATE",
-			"preemptible":       false,
-		}
+func setSchedulingPolicy(machineClassSpec map[string]interface{}, isLiveMigrationAllowed bool, scheduling *apisgcp.Scheduling) {
+	var (
+		automaticRestart  = true
+		onHostMaintenance = OnHostMaintenanceMigrate
+	)
+
+	if scheduling != nil {
+		onHostMaintenance = ptr.Deref(scheduling.OnHostMaintenance, onHostMaintenance)
+		automaticRestart = ptr.Deref(scheduling.AutomaticRestart, automaticRestart)
+	}
+
+	// VMs with GPUs can't be live migrated, which is not only the case if the GPU is configured in the WorkerConfig but
+	// also for machine types with attached GPUs, hence the policy of the WorkerConfig is overruled.
+	if !isLiveMigrationAllowed {
+		onHostMaintenance = OnHostMaintenanceTerminate
+	}
+
+	machineClassSpec["scheduling"] = map[string]interface{}{
+		"automaticRestart":  automaticRestart,
+		"onHostMaintenance": onHostMaintenance,
+		"preemptible":       false,
 	}
 }
 
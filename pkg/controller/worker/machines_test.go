@@ -33,12 +33,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-gcp/charts"
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/events"
 	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
 	gcpWorker "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
@@ -75,7 +77,7 @@ var _ = Describe("Machines", func() {
 
 	Context("WorkerDelegate", func() {
 		BeforeEach(func() {
-			workerDelegate, _ = NewWorkerDelegate(nil, scheme, nil, "", nil, nil)
+			workerDelegate, _ = NewWorkerDelegate(nil, scheme, nil, nil, nil, "", nil, nil)
 		})
 
 		Describe("#GenerateMachineDeployments, #DeployMachineClasses", func() {
@@ -423,7 +425,7 @@ var _ = Describe("Machines", func() {
 				workerPoolHash1, _ = worker.WorkerPoolHash(w.Spec.Pools[0], cluster, []string{}, additionalData1)
 				workerPoolHash2, _ = worker.WorkerPoolHash(w.Spec.Pools[1], cluster, []string{}, additionalData2)
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, clusterWithoutImages)
 			})
 
 			expectedUserDataSecretRefRead := func() {
@@ -635,7 +637,8 @@ var _ = Describe("Machines", func() {
 							},
 						}),
 					}
-					workerDelegateCloudRouter, _ := NewWorkerDelegate(c, scheme, chartApplier, "", workerCloudRouter, cluster)
+					recorder := record.NewFakeRecorder(10)
+					workerDelegateCloudRouter, _ := NewWorkerDelegate(c, scheme, nil, events.NewRecorder(recorder, nil), chartApplier, "", workerCloudRouter, cluster)
 
 					expectedUserDataSecretRefRead()
 
@@ -681,6 +684,8 @@ var _ = Describe("Machines", func() {
 
 					err = workerDelegateCloudRouter.UpdateMachineImagesStatus(ctx)
 					Expect(err).NotTo(HaveOccurred())
+					Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf("Normal MachineImageResolved Machine image %s %s (%s) resolved to %s", machineImageName, machineImageVersion, archAMD, machineImage))))
+					Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf("Normal MachineImageResolved Machine image %s %s (%s) resolved to %s", machineImageName, machineImageVersion, archARM, machineImage))))
 
 					// Test WorkerDelegate.GenerateMachineDeployments()
 					result, err := workerDelegateCloudRouter.GenerateMachineDeployments(ctx)
@@ -691,7 +696,7 @@ var _ = Describe("Machines", func() {
 
 			It("should succeed with ipv4 cluster", func() {
 				cluster.Shoot.Spec.Networking.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}
-				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				wd, err := NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
 				Expect(err).NotTo(HaveOccurred())
 				expectedUserDataSecretRefRead()
 				_, err = wd.GenerateMachineDeployments(ctx)
@@ -706,6 +711,66 @@ var _ = Describe("Machines", func() {
 				}
 			})
 
+			It("should succeed with ipv4 cluster using alias IP ranges for the pods", func() {
+				cluster.Shoot.Spec.Networking.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}
+				cluster.Shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.ControlPlaneConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "ControlPlaneConfig",
+						},
+						CloudControllerManager: &apiv1alpha1.CloudControllerManagerConfig{
+							NodeIPAMMode: ptr.To("AliasIP"),
+						},
+					}),
+				}
+				wd, err := NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				workerDelegate := wd.(*WorkerDelegate)
+				mClasses := workerDelegate.GetMachineClasses()
+				for _, mClz := range mClasses {
+					Expect(mClz["networkInterfaces"]).To(Equal([]map[string]interface{}{{
+						"subnetwork":          subnetName,
+						"disableExternalIP":   true,
+						"stackType":           "IPV4_ONLY",
+						"ipCidrRange":         "/24",
+						"subnetworkRangeName": "ipv4-pod-cidr",
+					}}))
+				}
+			})
+
+			It("should add the firewall target tags of load balancers to the machines", func() {
+				cluster.Shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.ControlPlaneConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "ControlPlaneConfig",
+						},
+						LoadBalancer: &apiv1alpha1.LoadBalancerConfig{
+							FirewallTargetTags: []string{"lb-backends"},
+						},
+					}),
+				}
+				wd, err := NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				workerDelegate := wd.(*WorkerDelegate)
+				mClasses := workerDelegate.GetMachineClasses()
+				for _, mClz := range mClasses {
+					Expect(mClz["tags"]).To(Equal([]string{
+						namespace,
+						"kubernetes-io-cluster-" + namespace,
+						"kubernetes-io-role-node",
+						"lb-backends",
+					}))
+				}
+			})
+
 			It("should succeed with dual-stack cluster", func() {
 				cluster.Shoot.Spec.Networking.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
@@ -722,7 +787,7 @@ var _ = Describe("Machines", func() {
 						},
 					}),
 				}
-				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				wd, err := NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
 				Expect(err).NotTo(HaveOccurred())
 				expectedUserDataSecretRefRead()
 				_, err = wd.GenerateMachineDeployments(ctx)
@@ -743,7 +808,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the version is invalid", func() {
 				clusterWithoutImages.Shoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -753,7 +818,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -765,7 +830,7 @@ var _ = Describe("Machines", func() {
 					Raw: encode(&api.InfrastructureStatus{}),
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -775,7 +840,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the machine image for given architecture cannot be found", func() {
 				w.Spec.Pools[0].Architecture = ptr.To(archFAKE)
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -783,7 +848,7 @@ var _ = Describe("Machines", func() {
 			})
 
 			It("should fail because the machine image cannot be found", func() {
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, clusterWithoutImages)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -793,13 +858,182 @@ var _ = Describe("Machines", func() {
 			It("should fail because the volume size cannot be decoded", func() {
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
 				Expect(result).To(BeNil())
 			})
 
+			It("should use the scheduling policy from the worker config", func() {
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						Scheduling: &api.Scheduling{
+							OnHostMaintenance: ptr.To("TERMINATE"),
+							AutomaticRestart:  ptr.To(false),
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				workerDelegate := wd.(*WorkerDelegate)
+				mClasses := workerDelegate.GetMachineClasses()
+				for _, mClz := range mClasses {
+					className := mClz["name"].(string)
+					if strings.Contains(className, namePool2) {
+						Expect(mClz["scheduling"]).To(Equal(map[string]interface{}{
+							"automaticRestart":  false,
+							"onHostMaintenance": "TERMINATE",
+							"preemptible":       false,
+						}))
+					}
+				}
+			})
+
+			It("should only change the worker pool hash if scheduling options are set", func() {
+				classNamesOfPool2 := func(scheduling *api.Scheduling) []string {
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{Scheduling: scheduling}),
+					}
+
+					wd, err := NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
+					Expect(err).NotTo(HaveOccurred())
+					expectedUserDataSecretRefRead()
+					machineDeployments, err := wd.GenerateMachineDeployments(ctx)
+					Expect(err).NotTo(HaveOccurred())
+
+					var classNames []string
+					for _, machineDeployment := range machineDeployments {
+						if strings.Contains(machineDeployment.ClassName, namePool2) {
+							classNames = append(classNames, machineDeployment.ClassName)
+						}
+					}
+					return classNames
+				}
+
+				classNames := classNamesOfPool2(nil)
+				Expect(classNames).NotTo(BeEmpty())
+				Expect(classNamesOfPool2(&api.Scheduling{})).To(Equal(classNames))
+				Expect(classNamesOfPool2(&api.Scheduling{OnHostMaintenance: ptr.To("TERMINATE")})).NotTo(ConsistOf(classNames))
+				Expect(classNamesOfPool2(&api.Scheduling{AutomaticRestart: ptr.To(false)})).NotTo(ConsistOf(classNames))
+			})
+
+			It("should always terminate VMs with GPUs on host maintenance", func() {
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						NodeTemplate: &extensionsv1alpha1.NodeTemplate{
+							Capacity: corev1.ResourceList{"gpu": resource.MustParse("1")},
+						},
+						Scheduling: &api.Scheduling{
+							OnHostMaintenance: ptr.To("MIGRATE"),
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				workerDelegate := wd.(*WorkerDelegate)
+				mClasses := workerDelegate.GetMachineClasses()
+				for _, mClz := range mClasses {
+					className := mClz["name"].(string)
+					if strings.Contains(className, namePool2) {
+						Expect(mClz["scheduling"]).To(Equal(map[string]interface{}{
+							"automaticRestart":  true,
+							"onHostMaintenance": "TERMINATE",
+							"preemptible":       false,
+						}))
+					}
+				}
+			})
+
+			It("should attach the additional network interfaces from the worker config", func() {
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						AdditionalNetworkInterfaces: []api.AdditionalNetworkInterface{
+							{Network: "foo", Subnetwork: "foo-subnet", Routes: []string{"10.0.0.0/8", "192.168.0.0/16"}},
+							{Network: "bar", Subnetwork: "bar-subnet"},
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				workerDelegate := wd.(*WorkerDelegate)
+				mClasses := workerDelegate.GetMachineClasses()
+				for _, mClz := range mClasses {
+					className := mClz["name"].(string)
+					if strings.Contains(className, namePool2) {
+						Expect(mClz["networkInterfaces"]).To(Equal([]map[string]interface{}{
+							{
+								"subnetwork":        subnetName,
+								"disableExternalIP": true,
+							},
+							{
+								"network":           "foo",
+								"subnetwork":        "foo-subnet",
+								"disableExternalIP": true,
+							},
+							{
+								"network":           "bar",
+								"subnetwork":        "bar-subnet",
+								"disableExternalIP": true,
+							},
+						}))
+						Expect(mClz["metadata"]).To(ContainElement(map[string]string{
+							"key":   "gardener-additional-network-routes",
+							"value": "1 10.0.0.0/8\n1 192.168.0.0/16",
+						}))
+					}
+				}
+			})
+
+			It("should configure the node bootstrap from the worker config", func() {
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						NodeBootstrap: &api.NodeBootstrap{
+							LocalSSD:              &api.LocalSSDBootstrap{MountPath: "/mnt/local-ssd"},
+							ConcealMetadataServer: ptr.To(true),
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				workerDelegate := wd.(*WorkerDelegate)
+				mClasses := workerDelegate.GetMachineClasses()
+				for _, mClz := range mClasses {
+					className := mClz["name"].(string)
+					if strings.Contains(className, namePool2) {
+						Expect(mClz["networkInterfaces"]).To(Equal([]map[string]interface{}{
+							{
+								"subnetwork":        subnetName,
+								"disableExternalIP": true,
+							},
+						}))
+						Expect(mClz["metadata"]).To(ConsistOf(
+							map[string]string{"key": "block-project-ssh-keys", "value": "TRUE"},
+							map[string]string{"key": "gardener-local-ssd-mount-path", "value": "/mnt/local-ssd"},
+							map[string]string{"key": "gardener-conceal-metadata-server", "value": "true"},
+						))
+					} else {
+						Expect(mClz["metadata"]).To(ConsistOf(map[string]string{"key": "block-project-ssh-keys", "value": "TRUE"}))
+					}
+				}
+			})
+
 			It("should set expected machineControllerManager settings on machine deployment", func() {
 				testDrainTimeout := metav1.Duration{Duration: 10 * time.Minute}
 				testHealthTimeout := metav1.Duration{Duration: 20 * time.Minute}
@@ -814,7 +1048,7 @@ var _ = Describe("Machines", func() {
 					NodeConditions:         testNodeConditions,
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
 
 				expectedUserDataSecretRefRead()
 
@@ -849,7 +1083,7 @@ var _ = Describe("Machines", func() {
 				expectedCapacity := w.Spec.Pools[0].NodeTemplate.Capacity.DeepCopy()
 				maps.Copy(expectedCapacity, customResources)
 
-				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				wd, err := NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
 				Expect(err).NotTo(HaveOccurred())
 				expectedUserDataSecretRefRead()
 				_, err = wd.GenerateMachineDeployments(ctx)
@@ -874,7 +1108,7 @@ var _ = Describe("Machines", func() {
 					ScaleDownUtilizationThreshold:    ptr.To("0.5"),
 				}
 				w.Spec.Pools[1].ClusterAutoscaler = nil
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, nil, nil, chartApplier, "", w, cluster)
 
 				expectedUserDataSecretRefRead()
 
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	// clientCacheTTL is the time for which the clients created by the factory are cached.
+	clientCacheTTL = time.Hour
+	// clientCacheSize is the maximum number of cached clients.
+	clientCacheSize = 1024
+)
+
+// clientCache caches the clients created by the factory, mapped to the service and the secret they were created for.
+// The clients are created for each reconciliation, hence caching them avoids creating new token sources and fetching
+// new access tokens every time.
+var clientCache = utilcache.NewLRUExpireCache(clientCacheSize)
+
+type cacheEntry struct {
+	fingerprint string
+	client      any
+}
+
+// cachedClient returns the cached client of the given service for the secret with the given reference. A new client is
+// created if none is cached or if the credentials of the secret have changed since the client was created, e.g.
+// because they were rotated.
+func cachedClient[T any](ctx context.Context, c client.Client, sr corev1.SecretReference, service string, newClient func(context.Context, *gcp.CredentialsConfig) (T, error)) (T, error) {
+	var zero T
+
+	credentialsConfig, err := gcp.GetCredentialsConfigFromSecretReference(ctx, c, sr)
+	if err != nil {
+		return zero, err
+	}
+
+	key := service + "/" + sr.Namespace + "/" + sr.Name
+	fingerprint := credentialsFingerprint(credentialsConfig)
+	if cached, ok := clientCache.Get(key); ok {
+		if entry := cached.(cacheEntry); entry.fingerprint == fingerprint {
+			return entry.client.(T), nil
+		}
+	}
+
+	// The client outlives the context of the reconciliation it is created in, hence it must not be bound to it.
+	gcpClient, err := newClient(context.Background(), credentialsConfig)
+	if err != nil {
+		return zero, err
+	}
+
+	clientCache.Add(key, cacheEntry{fingerprint: fingerprint, client: gcpClient}, clientCacheTTL)
+	return gcpClient, nil
+}
+
+// credentialsFingerprint returns a fingerprint of the given credentials configuration which changes whenever the
+// credentials or the options of the clients change. The workload identity token is not part of the fingerprint, as
+// it is retrieved from the secret whenever a new access token is needed.
+func credentialsFingerprint(credentialsConfig *gcp.CredentialsConfig) string {
+	return fmt.Sprintf("%x", sha256.Sum256(fmt.Appendf(nil, "%s\x00%s\x00%s",
+		credentialsConfig.Raw,
+		credentialsConfig.ProjectID,
+		credentialsConfig.QuotaProject,
+	)))
+}
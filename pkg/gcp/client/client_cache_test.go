@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+var _ = Describe("Client cache", func() {
+	var (
+		ctx = context.TODO()
+
+		c         client.Client
+		secret    *corev1.Secret
+		secretRef corev1.SecretReference
+		factory   Factory
+	)
+
+	BeforeEach(func() {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--cache", Name: "cloudprovider"},
+			Data: map[string][]byte{
+				gcp.ServiceAccountJSONField: []byte(`{"type": "service_account", "project_id": "my-project", "client_email": "foo@my-project.iam.gserviceaccount.com"}`),
+			},
+		}
+		secretRef = corev1.SecretReference{Namespace: secret.Namespace, Name: secret.Name}
+		c = fakeclient.NewClientBuilder().WithObjects(secret).Build()
+		factory = New()
+
+		DeferCleanup(func() {
+			clientCache.RemoveAll(func(any) bool { return true })
+		})
+	})
+
+	It("should reuse the client for the same secret", func() {
+		computeClient, err := factory.Compute(ctx, c, secretRef)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(New().Compute(ctx, c, secretRef)).To(BeIdenticalTo(computeClient))
+	})
+
+	It("should create a new client once the credentials are rotated", func() {
+		computeClient, err := factory.Compute(ctx, c, secretRef)
+		Expect(err).NotTo(HaveOccurred())
+
+		secret.Data[gcp.ServiceAccountJSONField] = []byte(`{"type": "service_account", "project_id": "my-project", "client_email": "bar@my-project.iam.gserviceaccount.com"}`)
+		Expect(c.Update(ctx, secret)).To(Succeed())
+
+		Expect(factory.Compute(ctx, c, secretRef)).NotTo(BeIdenticalTo(computeClient))
+	})
+})
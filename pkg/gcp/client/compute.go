@@ -13,7 +13,6 @@ import (
 
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/googleapi"
-	"google.golang.org/api/option"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
@@ -40,6 +39,17 @@ type ComputeClient interface {
 	// DeleteDisk deletes the Disk. Returns no error if the Disk is not found.
 	DeleteDisk(ctx context.Context, zone, diskName string) error
 
+	// GetStoragePool returns the StoragePool specified by zone and name. Returns nil if the StoragePool is not found.
+	GetStoragePool(ctx context.Context, zone, name string) (*compute.StoragePool, error)
+	// InsertStoragePool creates a new StoragePool with the given specification.
+	InsertStoragePool(ctx context.Context, zone string, pool *compute.StoragePool) (*compute.StoragePool, error)
+	// UpdateStoragePool updates the provisioned capacity, IOPS and throughput of the StoragePool.
+	UpdateStoragePool(ctx context.Context, zone, name string, pool *compute.StoragePool) (*compute.StoragePool, error)
+	// DeleteStoragePool deletes the StoragePool. Returns no error if the StoragePool is not found.
+	DeleteStoragePool(ctx context.Context, zone, name string) error
+	// ListStoragePools lists the StoragePools of all zones.
+	ListStoragePools(ctx context.Context, opts StoragePoolListOpts) ([]*compute.StoragePool, error)
+
 	// InsertNetwork creates a Network with the given specification.
 	InsertNetwork(ctx context.Context, nw *compute.Network) (*compute.Network, error)
 	// GetNetwork reads provider information for the specified Network.
@@ -90,6 +100,14 @@ type ComputeClient interface {
 
 	// GetRegion returns the Region specified.
 	GetRegion(ctx context.Context, region string) (*compute.Region, error)
+	// ListZones returns the names of the zones of the Region specified. The result is cached.
+	ListZones(ctx context.Context, region string) ([]string, error)
+	// GetMachineType returns the MachineType specified by zone and name. Returns nil if the MachineType is not found.
+	// Found MachineTypes are cached.
+	GetMachineType(ctx context.Context, zone, name string) (*compute.MachineType, error)
+	// GetForwardingRule returns the ForwardingRule specified by region and name. The ForwardingRule is a global one if
+	// the region is empty. Returns nil if the ForwardingRule is not found.
+	GetForwardingRule(ctx context.Context, region, name string) (*compute.ForwardingRule, error)
 }
 
 type computeClient struct {
@@ -107,8 +125,12 @@ func NewComputeClient(ctx context.Context, credentialsConfig *gcp.CredentialsCon
 	if err != nil {
 		return nil, err
 	}
+	httpClient.Transport = newComputeRateLimitTransport(newMetricsTransport(httpClient.Transport, serviceCompute), credentialsConfig.ProjectID)
+	if DefaultComputeOperations.RequestTimeout != nil {
+		httpClient.Timeout = DefaultComputeOperations.RequestTimeout.Duration
+	}
 
-	service, err := compute.NewService(ctx, option.WithHTTPClient(httpClient))
+	service, err := compute.NewService(ctx, clientOptions(httpClient, DefaultAPIEndpoints.Compute)...)
 	if err != nil {
 		return nil, err
 	}
@@ -202,6 +224,87 @@ func (c *computeClient) DeleteDisk(ctx context.Context, zone, diskName string) e
 	return c.wait(ctx, op)
 }
 
+// GetStoragePool returns the StoragePool specified by zone and name. Returns nil if the StoragePool is not found.
+func (c *computeClient) GetStoragePool(ctx context.Context, zone, name string) (*compute.StoragePool, error) {
+	pool, err := c.service.StoragePools.Get(c.projectID, zone, name).Context(ctx).Do()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return pool, nil
+}
+
+// InsertStoragePool creates a new StoragePool with the given specification.
+func (c *computeClient) InsertStoragePool(ctx context.Context, zone string, pool *compute.StoragePool) (*compute.StoragePool, error) {
+	op, err := c.service.StoragePools.Insert(c.projectID, zone, pool).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	err = c.wait(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetStoragePool(ctx, zone, pool.Name)
+}
+
+// UpdateStoragePool updates the provisioned capacity, IOPS and throughput of the StoragePool.
+func (c *computeClient) UpdateStoragePool(ctx context.Context, zone, name string, pool *compute.StoragePool) (*compute.StoragePool, error) {
+	op, err := c.service.StoragePools.Update(c.projectID, zone, name, pool).
+		UpdateMask("poolProvisionedCapacityGb,poolProvisionedIops,poolProvisionedThroughput").
+		Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	err = c.wait(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetStoragePool(ctx, zone, name)
+}
+
+// DeleteStoragePool deletes the StoragePool. Returns no error if the StoragePool is not found.
+func (c *computeClient) DeleteStoragePool(ctx context.Context, zone, name string) error {
+	op, err := c.service.StoragePools.Delete(c.projectID, zone, name).Context(ctx).Do()
+	if IgnoreNotFoundError(err) != nil {
+		return err
+	}
+	if IsNotFoundError(err) {
+		return nil
+	}
+	return c.wait(ctx, op)
+}
+
+// StoragePoolListOpts are options for the ListStoragePools function.
+type StoragePoolListOpts struct {
+	// Filter is server side filtering applied by the GCP API.
+	Filter string
+}
+
+// ListStoragePools lists the StoragePools of all zones.
+func (c *computeClient) ListStoragePools(ctx context.Context, opts StoragePoolListOpts) ([]*compute.StoragePool, error) {
+	var res []*compute.StoragePool
+
+	spCall := c.service.StoragePools.AggregatedList(c.projectID).Context(ctx)
+	if len(opts.Filter) > 0 {
+		spCall = spCall.Filter(opts.Filter)
+	}
+
+	if err := spCall.Pages(ctx, func(list *compute.StoragePoolAggregatedList) error {
+		for _, scopedList := range list.Items {
+			for _, pool := range scopedList.StoragePools {
+				if pool == nil {
+					continue
+				}
+				res = append(res, pool)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // InsertNetwork creates a Network with the given specification.
 func (c *computeClient) InsertNetwork(ctx context.Context, n *compute.Network) (*compute.Network, error) {
 	op, err := c.service.Networks.Insert(c.projectID, n).Context(ctx).Do()
@@ -541,6 +644,63 @@ func (c *computeClient) GetRegion(ctx context.Context, region string) (*compute.
 	return c.service.Regions.Get(c.projectID, region).Context(ctx).Do()
 }
 
+// ListZones returns the names of the zones of the Region specified. The result is cached, unlike the Region itself,
+// which contains the current usage of the quotas.
+func (c *computeClient) ListZones(ctx context.Context, region string) ([]string, error) {
+	key := lookupCacheKey("zones", c.projectID, region)
+	if zones, ok := lookupCache.Get(key); ok {
+		return zones.([]string), nil
+	}
+
+	resp, err := c.service.Regions.Get(c.projectID, region).Fields("zones").Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	zones := make([]string, 0, len(resp.Zones))
+	for _, zone := range resp.Zones {
+		zones = append(zones, parseResourceName(zone))
+	}
+
+	lookupCache.Add(key, zones, lookupCacheTTL)
+	return zones, nil
+}
+
+// GetMachineType returns the MachineType specified by zone and name. Returns nil if the MachineType is not found.
+// Found MachineTypes are cached.
+func (c *computeClient) GetMachineType(ctx context.Context, zone, name string) (*compute.MachineType, error) {
+	key := lookupCacheKey("machineTypes", c.projectID, zone, name)
+	if machineType, ok := lookupCache.Get(key); ok {
+		return machineType.(*compute.MachineType), nil
+	}
+
+	machineType, err := c.service.MachineTypes.Get(c.projectID, zone, name).Context(ctx).Do()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+
+	lookupCache.Add(key, machineType, lookupCacheTTL)
+	return machineType, nil
+}
+
+// GetForwardingRule returns the ForwardingRule specified by region and name. The ForwardingRule is a global one if
+// the region is empty. Returns nil if the ForwardingRule is not found.
+func (c *computeClient) GetForwardingRule(ctx context.Context, region, name string) (*compute.ForwardingRule, error) {
+	var (
+		forwardingRule *compute.ForwardingRule
+		err            error
+	)
+	if len(region) == 0 {
+		forwardingRule, err = c.service.GlobalForwardingRules.Get(c.projectID, name).Context(ctx).Do()
+	} else {
+		forwardingRule, err = c.service.ForwardingRules.Get(c.projectID, region, name).Context(ctx).Do()
+	}
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return forwardingRule, nil
+}
+
 // WaitForIPv6Cidr waits for the ipv6 cidr block association
 func (c *computeClient) WaitForIPv6Cidr(ctx context.Context, region, subnetID string) (string, error) {
 	var ipv6CidrBlock string
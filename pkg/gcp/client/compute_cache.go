@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"strings"
+	"time"
+
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+)
+
+const (
+	// lookupCacheTTL is the time for which the results of lookups of stable data are cached.
+	lookupCacheTTL = time.Hour
+	// lookupCacheSize is the maximum number of cached lookup results.
+	lookupCacheSize = 4096
+)
+
+// lookupCache caches the results of lookups of stable data of the Compute Engine API, e.g. machine types and the zones
+// of regions. It is shared by all compute clients, as clients are created for each reconciliation and the data rarely
+// changes. The cached objects must not be modified.
+var lookupCache = utilcache.NewLRUExpireCache(lookupCacheSize)
+
+// lookupCacheKey returns the key of the lookup cache for the given kind of data and the given identifying parts, e.g.
+// the project, zone and name of a machine type.
+func lookupCacheKey(kind string, parts ...string) string {
+	return kind + "/" + strings.Join(parts, "/")
+}
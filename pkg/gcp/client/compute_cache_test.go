@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+)
+
+var _ = Describe("Compute lookup cache", func() {
+	var (
+		ctx = context.TODO()
+
+		server   *httptest.Server
+		requests atomic.Int32
+		client   *computeClient
+	)
+
+	BeforeEach(func() {
+		requests.Store(0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/compute/v1/projects/cache-project/zones/europe-west1-b/machineTypes/n1-standard-2":
+				_, _ = w.Write([]byte(`{"name":"n1-standard-2","guestCpus":2}`))
+			case "/compute/v1/projects/cache-project/regions/europe-west1":
+				_, _ = w.Write([]byte(`{"zones":["https://www.googleapis.com/compute/v1/projects/cache-project/zones/europe-west1-b","https://www.googleapis.com/compute/v1/projects/cache-project/zones/europe-west1-c"]}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":{"code":404,"message":"not found"}}`))
+			}
+		}))
+
+		service, err := compute.NewService(ctx, option.WithEndpoint(server.URL+"/compute/v1/"), option.WithHTTPClient(server.Client()))
+		Expect(err).NotTo(HaveOccurred())
+		client = &computeClient{service: service, projectID: "cache-project"}
+
+		DeferCleanup(func() {
+			server.Close()
+			lookupCache.RemoveAll(func(any) bool { return true })
+		})
+	})
+
+	It("should look up a machine type only once", func() {
+		for range 3 {
+			machineType, err := client.GetMachineType(ctx, "europe-west1-b", "n1-standard-2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machineType.GuestCpus).To(Equal(int64(2)))
+		}
+		Expect(requests.Load()).To(Equal(int32(1)))
+	})
+
+	It("should not cache machine types which are not found", func() {
+		for range 2 {
+			machineType, err := client.GetMachineType(ctx, "europe-west1-b", "unknown")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machineType).To(BeNil())
+		}
+		Expect(requests.Load()).To(Equal(int32(2)))
+	})
+
+	It("should look up the zones of a region only once", func() {
+		for range 3 {
+			Expect(client.ListZones(ctx, "europe-west1")).To(Equal([]string{"europe-west1-b", "europe-west1-c"}))
+		}
+		Expect(requests.Load()).To(Equal(int32(1)))
+	})
+})
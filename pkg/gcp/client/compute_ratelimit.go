@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/util/flowcontrol"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+)
+
+// DefaultComputeRateLimits are the rate limits of the requests to the Compute Engine API. Requests are not limited if no
+// limit is configured for them.
+var DefaultComputeRateLimits config.ComputeRateLimits
+
+// computeRequestGroup is a group of requests to the Compute Engine API which share a rate limit.
+type computeRequestGroup string
+
+const (
+	computeRequestGroupReads           computeRequestGroup = "reads"
+	computeRequestGroupWrites          computeRequestGroup = "writes"
+	computeRequestGroupHeavyOperations computeRequestGroup = "heavyOperations"
+)
+
+// computeRateLimiters are the rate limiters of the requests to the Compute Engine API, mapped to the projects and the
+// request groups they limit. They are shared by all compute clients, as the quotas of the Compute Engine API apply per
+// project.
+var computeRateLimiters = struct {
+	sync.Mutex
+	limiters map[string]flowcontrol.RateLimiter
+}{limiters: map[string]flowcontrol.RateLimiter{}}
+
+// computeRateLimiter returns the shared rate limiter of the given request group for the project with the given ID. It
+// returns nil if the requests of the group are not limited.
+func computeRateLimiter(projectID string, group computeRequestGroup) flowcontrol.RateLimiter {
+	var limit *config.RateLimit
+	switch group {
+	case computeRequestGroupReads:
+		limit = DefaultComputeRateLimits.Reads
+	case computeRequestGroupWrites:
+		limit = DefaultComputeRateLimits.Writes
+	case computeRequestGroupHeavyOperations:
+		limit = DefaultComputeRateLimits.HeavyOperations
+	}
+	if limit == nil {
+		return nil
+	}
+
+	key := projectID + "/" + string(group)
+
+	computeRateLimiters.Lock()
+	defer computeRateLimiters.Unlock()
+
+	limiter, ok := computeRateLimiters.limiters[key]
+	if !ok {
+		limiter = flowcontrol.NewTokenBucketRateLimiter(limit.QPS, max(int(limit.Burst), 1))
+		computeRateLimiters.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// computeRequestGroupOf returns the group of the given request to the Compute Engine API. Aggregated list requests are
+// heavy-weight operations, all other requests are reads or writes depending on their method.
+func computeRequestGroupOf(req *http.Request) computeRequestGroup {
+	if strings.Contains(req.URL.Path, "/aggregated/") {
+		return computeRequestGroupHeavyOperations
+	}
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return computeRequestGroupReads
+	}
+	return computeRequestGroupWrites
+}
+
+// computeRateLimitTransport is a http.RoundTripper which rate limits the requests to the Compute Engine API per project
+// and request group.
+type computeRateLimitTransport struct {
+	base      http.RoundTripper
+	projectID string
+}
+
+func newComputeRateLimitTransport(base http.RoundTripper, projectID string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &computeRateLimitTransport{base: base, projectID: projectID}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *computeRateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if limiter := computeRateLimiter(t.projectID, computeRequestGroupOf(req)); limiter != nil {
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+)
+
+var _ = Describe("Compute rate limiting", func() {
+	BeforeEach(func() {
+		DefaultComputeRateLimits = config.ComputeRateLimits{
+			Reads: &config.RateLimit{QPS: 1, Burst: 2},
+		}
+		DeferCleanup(func() {
+			DefaultComputeRateLimits = config.ComputeRateLimits{}
+		})
+	})
+
+	DescribeTable("#computeRequestGroupOf",
+		func(method, path string, expected computeRequestGroup) {
+			req := httptest.NewRequest(method, "https://compute.googleapis.com"+path, nil)
+			Expect(computeRequestGroupOf(req)).To(Equal(expected))
+		},
+		Entry("get", http.MethodGet, "/compute/v1/projects/foo/zones/europe-west1-b/instances/bar", computeRequestGroupReads),
+		Entry("list", http.MethodGet, "/compute/v1/projects/foo/global/firewalls", computeRequestGroupReads),
+		Entry("aggregated list", http.MethodGet, "/compute/v1/projects/foo/aggregated/instances", computeRequestGroupHeavyOperations),
+		Entry("insert", http.MethodPost, "/compute/v1/projects/foo/global/firewalls", computeRequestGroupWrites),
+		Entry("delete", http.MethodDelete, "/compute/v1/projects/foo/global/firewalls/bar", computeRequestGroupWrites),
+	)
+
+	It("should share the rate limiters of a project", func() {
+		limiter := computeRateLimiter("shared-project", computeRequestGroupReads)
+		Expect(limiter).NotTo(BeNil())
+		Expect(limiter.QPS()).To(Equal(float32(1)))
+		Expect(computeRateLimiter("shared-project", computeRequestGroupReads)).To(BeIdenticalTo(limiter))
+		Expect(computeRateLimiter("other-project", computeRequestGroupReads)).NotTo(BeIdenticalTo(limiter))
+	})
+
+	It("should not limit requests without a configured limit", func() {
+		Expect(computeRateLimiter("unlimited-project", computeRequestGroupWrites)).To(BeNil())
+		Expect(computeRateLimiter("unlimited-project", computeRequestGroupHeavyOperations)).To(BeNil())
+	})
+})
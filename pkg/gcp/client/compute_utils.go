@@ -8,31 +8,52 @@ import (
 
 	"google.golang.org/api/compute/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 )
 
 const (
 	pollInterval = 10 * time.Second
 )
 
+// DefaultComputeOperations configures the waiting for the operations of the Compute Engine API and the deadline of the
+// single requests. It is set from the controller configuration when the extension starts.
+var DefaultComputeOperations config.ComputeOperations
+
 // Wait waits for async operations to complete.
 func (c *computeClient) wait(ctx context.Context, op *compute.Operation) error {
-	return wait.PollUntilContextCancel(ctx, pollInterval, true, c.waitOperation(op))
+	interval := pollInterval
+	if DefaultComputeOperations.PollInterval != nil {
+		interval = DefaultComputeOperations.PollInterval.Duration
+	}
+
+	if DefaultComputeOperations.Timeout != nil {
+		if err := wait.PollUntilContextTimeout(ctx, interval, DefaultComputeOperations.Timeout.Duration, true, c.waitOperation(op)); err != nil {
+			if wait.Interrupted(err) && ctx.Err() == nil {
+				return fmt.Errorf("operation %q did not complete within %s", op.Name, DefaultComputeOperations.Timeout.Duration)
+			}
+			return err
+		}
+		return nil
+	}
+	return wait.PollUntilContextCancel(ctx, interval, true, c.waitOperation(op))
 }
 
-func (c *computeClient) QueryOperation(op *compute.Operation) (*compute.Operation, error) {
+// QueryOperation returns the current state of the given zonal, regional or global operation.
+func (c *computeClient) QueryOperation(ctx context.Context, op *compute.Operation) (*compute.Operation, error) {
 	switch {
 	case op.Zone != "":
-		return c.service.ZoneOperations.Get(c.projectID, parseResourceName(op.Zone), op.Name).Do()
+		return c.service.ZoneOperations.Get(c.projectID, parseResourceName(op.Zone), op.Name).Context(ctx).Do()
 	case op.Region != "":
-		return c.service.RegionOperations.Get(c.projectID, parseResourceName(op.Region), op.Name).Do()
+		return c.service.RegionOperations.Get(c.projectID, parseResourceName(op.Region), op.Name).Context(ctx).Do()
 	default:
-		return c.service.GlobalOperations.Get(c.projectID, op.Name).Do()
+		return c.service.GlobalOperations.Get(c.projectID, op.Name).Context(ctx).Do()
 	}
 }
 
 func (c *computeClient) waitOperation(op *compute.Operation) func(context.Context) (bool, error) {
-	return func(_ context.Context) (bool, error) {
-		result, err := c.QueryOperation(op)
+	return func(ctx context.Context) (bool, error) {
+		result, err := c.QueryOperation(ctx, op)
 		if err != nil {
 			return false, fmt.Errorf("failed to query operation [Name=%s]: %s", op.Name, err)
 		}
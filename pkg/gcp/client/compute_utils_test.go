@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+)
+
+var _ = Describe("Compute operations", func() {
+	var (
+		ctx = context.TODO()
+
+		server  *httptest.Server
+		polls   atomic.Int32
+		doneAt  int32
+		client  *computeClient
+		zonalOp = &compute.Operation{Name: "op", Zone: "https://www.googleapis.com/compute/v1/projects/foo/zones/europe-west1-b"}
+	)
+
+	BeforeEach(func() {
+		polls.Store(0)
+		doneAt = 3
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/compute/v1/projects/foo/zones/europe-west1-b/operations/op"))
+			w.Header().Set("Content-Type", "application/json")
+			if polls.Add(1) >= doneAt {
+				_, _ = w.Write([]byte(`{"name":"op","status":"DONE"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"name":"op","status":"RUNNING"}`))
+		}))
+
+		service, err := compute.NewService(ctx, option.WithEndpoint(server.URL+"/compute/v1/"), option.WithHTTPClient(server.Client()))
+		Expect(err).NotTo(HaveOccurred())
+		client = &computeClient{service: service, projectID: "foo"}
+
+		DeferCleanup(func() {
+			server.Close()
+			DefaultComputeOperations = config.ComputeOperations{}
+		})
+	})
+
+	It("should poll the operation in the configured interval until it is done", func() {
+		DefaultComputeOperations = config.ComputeOperations{PollInterval: &metav1.Duration{Duration: 10 * time.Millisecond}}
+
+		Expect(client.wait(ctx, zonalOp)).To(Succeed())
+		Expect(polls.Load()).To(Equal(int32(3)))
+	})
+
+	It("should give up waiting for the operation after the configured timeout", func() {
+		doneAt = 1000
+		DefaultComputeOperations = config.ComputeOperations{
+			PollInterval: &metav1.Duration{Duration: 10 * time.Millisecond},
+			Timeout:      &metav1.Duration{Duration: 50 * time.Millisecond},
+		}
+
+		Expect(client.wait(ctx, zonalOp)).To(MatchError(`operation "op" did not complete within 50ms`))
+	})
+})
@@ -6,20 +6,55 @@ package client
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
 	"reflect"
+	"slices"
 	"strings"
 
 	googledns "google.golang.org/api/dns/v1"
-	"google.golang.org/api/option"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
 // DNSClient is an interface which must be implemented by GCP DNS clients.
 type DNSClient interface {
-	GetManagedZones(ctx context.Context) (map[string]string, error)
+	GetManagedZones(ctx context.Context, projectID string) (map[string]string, error)
+	CreateManagedZone(ctx context.Context, projectID string, spec ManagedZoneSpec) (string, error)
+	DeleteManagedZone(ctx context.Context, managedZone string) error
 	CreateOrUpdateRecordSet(ctx context.Context, managedZone, name, recordType string, rrdatas []string, ttl int64) error
 	DeleteRecordSet(ctx context.Context, managedZone, name, recordType string) error
+	CreateOrUpdateRoutingPolicyItem(ctx context.Context, managedZone, name, recordType string, item RoutingPolicyItem, rrdatas []string, ttl int64) error
+	DeleteRoutingPolicyItem(ctx context.Context, managedZone, name, recordType string, item RoutingPolicyItem) error
+}
+
+// managedZoneLabel is the label which marks managed zones created by Gardener. Only managed zones with this label are
+// deleted again.
+const managedZoneLabel = "gardener-created"
+
+// RoutingPolicyItem identifies an item of the routing policy of a resource recordset. Items with a location belong to a
+// geolocation routing policy, all other items belong to a weighted round robin routing policy.
+type RoutingPolicyItem struct {
+	// Location is the location of an item of a geolocation routing policy.
+	Location string
+	// Index is the position of an item of a weighted round robin routing policy.
+	Index int
+	// Weight is the weight of an item of a weighted round robin routing policy.
+	Weight float64
+}
+
+// ManagedZoneSpec is the specification of a managed zone.
+type ManagedZoneSpec struct {
+	// DNSName is the DNS name of the managed zone.
+	DNSName string
+	// Private specifies whether the managed zone is only visible from the given networks.
+	Private bool
+	// Networks are the names or URLs of the VPC networks a private managed zone is visible from.
+	Networks []string
+	// DNSSEC specifies whether DNSSEC is enabled for the managed zone.
+	DNSSEC bool
 }
 
 type dnsClient struct {
@@ -33,8 +68,9 @@ func NewDNSClient(ctx context.Context, credentialsConfig *gcp.CredentialsConfig)
 	if err != nil {
 		return nil, err
 	}
+	httpClient.Transport = newDNSRateLimitTransport(newMetricsTransport(httpClient.Transport, serviceDNS))
 
-	service, err := googledns.NewService(ctx, option.WithHTTPClient(httpClient))
+	service, err := googledns.NewService(ctx, clientOptions(httpClient, DefaultAPIEndpoints.DNS)...)
 	if err != nil {
 		return nil, err
 	}
@@ -46,22 +82,91 @@ func NewDNSClient(ctx context.Context, credentialsConfig *gcp.CredentialsConfig)
 }
 
 // GetManagedZones returns a map of all managed zone DNS names mapped to their IDs, composed of the project ID and
-// their user assigned resource names.
-func (s *dnsClient) GetManagedZones(ctx context.Context) (map[string]string, error) {
+// their user assigned resource names. The managed zones are listed in the project with the given ID, or in the project
+// of the credentials if it is empty. Peering and forwarding zones are skipped as they cannot serve resource recordsets.
+func (s *dnsClient) GetManagedZones(ctx context.Context, projectID string) (map[string]string, error) {
+	if len(projectID) == 0 {
+		projectID = s.projectID
+	}
+
 	zones := make(map[string]string)
 	f := func(resp *googledns.ManagedZonesListResponse) error {
 		for _, zone := range resp.ManagedZones {
-			zones[normalizeZoneName(zone.DnsName)] = s.zoneID(zone.Name)
+			if zone.PeeringConfig != nil || zone.ForwardingConfig != nil {
+				continue
+			}
+			zones[normalizeZoneName(zone.DnsName)] = projectID + "/" + zone.Name
 		}
 		return nil
 	}
 
-	if err := s.service.ManagedZones.List(s.projectID).Pages(ctx, f); err != nil {
+	if err := s.service.ManagedZones.List(projectID).Pages(ctx, f); err != nil {
 		return nil, err
 	}
 	return zones, nil
 }
 
+// CreateManagedZone creates a managed zone with the given specification in the project with the given ID, or in the
+// project of the credentials if it is empty, and returns its ID. The name of the managed zone is derived from its DNS
+// name. If a managed zone with this name and DNS name already exists, its ID is returned.
+func (s *dnsClient) CreateManagedZone(ctx context.Context, projectID string, spec ManagedZoneSpec) (string, error) {
+	if len(projectID) == 0 {
+		projectID = s.projectID
+	}
+
+	zone := &googledns.ManagedZone{
+		Name:        ManagedZoneName(spec.DNSName),
+		DnsName:     ensureTrailingDot(spec.DNSName),
+		Description: "Managed zone created by Gardener",
+		Visibility:  "public",
+		Labels:      map[string]string{managedZoneLabel: "true"},
+	}
+	if spec.Private {
+		zone.Visibility = "private"
+		zone.PrivateVisibilityConfig = &googledns.ManagedZonePrivateVisibilityConfig{}
+		for _, network := range spec.Networks {
+			zone.PrivateVisibilityConfig.Networks = append(zone.PrivateVisibilityConfig.Networks, &googledns.ManagedZonePrivateVisibilityConfigNetwork{
+				NetworkUrl: networkURL(projectID, network),
+			})
+		}
+	}
+	if spec.DNSSEC {
+		zone.DnssecConfig = &googledns.ManagedZoneDnsSecConfig{State: "on"}
+	}
+
+	if _, err := s.service.ManagedZones.Create(projectID, zone).Context(ctx).Do(); err != nil {
+		if !IsErrorCode(err, http.StatusConflict) {
+			return "", err
+		}
+		existing, err := s.service.ManagedZones.Get(projectID, zone.Name).Context(ctx).Do()
+		if err != nil {
+			return "", err
+		}
+		if normalizeZoneName(existing.DnsName) != normalizeZoneName(spec.DNSName) {
+			return "", fmt.Errorf("managed zone %s already exists with DNS name %s", zone.Name, existing.DnsName)
+		}
+	}
+	return projectID + "/" + zone.Name, nil
+}
+
+// DeleteManagedZone deletes the managed zone with the given name or ID if it was created by Gardener and does not
+// contain any resource recordsets except for the ones created by Cloud DNS itself. Other managed zones are left
+// untouched.
+func (s *dnsClient) DeleteManagedZone(ctx context.Context, managedZone string) error {
+	project, managedZone := s.projectAndManagedZone(managedZone)
+	zone, err := s.service.ManagedZones.Get(project, managedZone).Context(ctx).Do()
+	if err != nil {
+		return IgnoreNotFoundError(err)
+	}
+	if zone.Labels[managedZoneLabel] != "true" {
+		return nil
+	}
+	if err := s.service.ManagedZones.Delete(project, managedZone).Context(ctx).Do(); err != nil && !IsContainerNotEmptyError(err) {
+		return IgnoreNotFoundError(err)
+	}
+	return nil
+}
+
 // CreateOrUpdateRecordSet creates or updates the resource recordset with the given name, record type, rrdatas, and ttl
 // in the managed zone with the given name or ID.
 func (s *dnsClient) CreateOrUpdateRecordSet(ctx context.Context, managedZone, name, recordType string, rrdatas []string, ttl int64) error {
@@ -103,6 +208,68 @@ func (s *dnsClient) DeleteRecordSet(ctx context.Context, managedZone, name, reco
 	return err
 }
 
+// CreateOrUpdateRoutingPolicyItem creates or updates the given item of the routing policy of the resource recordset with
+// the given name and record type in the managed zone with the given name or ID. Other items of the routing policy are
+// left untouched.
+func (s *dnsClient) CreateOrUpdateRoutingPolicyItem(ctx context.Context, managedZone, name, recordType string, item RoutingPolicyItem, rrdatas []string, ttl int64) error {
+	project, managedZone := s.projectAndManagedZone(managedZone)
+	name = ensureTrailingDot(name)
+	rrs, err := s.getResourceRecordSet(ctx, project, managedZone, name, recordType)
+	if err != nil {
+		return err
+	}
+	rrdatas = formatRrdatas(recordType, rrdatas)
+
+	routingPolicy := &googledns.RRSetRoutingPolicy{}
+	if rrs != nil && rrs.RoutingPolicy != nil {
+		routingPolicy = copyRoutingPolicy(rrs.RoutingPolicy)
+	}
+	changed, err := setRoutingPolicyItem(routingPolicy, item, rrdatas)
+	if err != nil {
+		return err
+	}
+
+	change := &googledns.Change{}
+	if rrs != nil {
+		if !changed && rrs.RoutingPolicy != nil && rrs.Ttl == ttl {
+			return nil
+		}
+		change.Deletions = append(change.Deletions, rrs)
+	}
+	change.Additions = append(change.Additions, &googledns.ResourceRecordSet{Name: name, Type: recordType, RoutingPolicy: routingPolicy, Ttl: ttl})
+	_, err = s.service.Changes.Create(project, managedZone, change).Context(ctx).Do()
+	return err
+}
+
+// DeleteRoutingPolicyItem deletes the given item of the routing policy of the resource recordset with the given name
+// and record type in the managed zone with the given name or ID. The resource recordset is deleted together with its
+// last item.
+func (s *dnsClient) DeleteRoutingPolicyItem(ctx context.Context, managedZone, name, recordType string, item RoutingPolicyItem) error {
+	project, managedZone := s.projectAndManagedZone(managedZone)
+	name = ensureTrailingDot(name)
+	rrs, err := s.getResourceRecordSet(ctx, project, managedZone, name, recordType)
+	if err != nil {
+		return err
+	}
+	if rrs == nil || rrs.RoutingPolicy == nil {
+		return nil
+	}
+
+	routingPolicy := copyRoutingPolicy(rrs.RoutingPolicy)
+	if !removeRoutingPolicyItem(routingPolicy, item) {
+		return nil
+	}
+
+	change := &googledns.Change{
+		Deletions: []*googledns.ResourceRecordSet{rrs},
+	}
+	if (routingPolicy.Geo != nil && len(routingPolicy.Geo.Items) > 0) || (routingPolicy.Wrr != nil && len(routingPolicy.Wrr.Items) > 0) {
+		change.Additions = append(change.Additions, &googledns.ResourceRecordSet{Name: name, Type: recordType, RoutingPolicy: routingPolicy, Ttl: rrs.Ttl})
+	}
+	_, err = s.service.Changes.Create(project, managedZone, change).Context(ctx).Do()
+	return err
+}
+
 func (s *dnsClient) getResourceRecordSet(ctx context.Context, project, managedZone, name, recordType string) (*googledns.ResourceRecordSet, error) {
 	resp, err := s.service.ResourceRecordSets.List(project, managedZone).Context(ctx).Name(name).Type(recordType).Do()
 	if err != nil {
@@ -114,10 +281,6 @@ func (s *dnsClient) getResourceRecordSet(ctx context.Context, project, managedZo
 	return nil, nil
 }
 
-func (s *dnsClient) zoneID(managedZone string) string {
-	return s.projectID + "/" + managedZone
-}
-
 func (s *dnsClient) projectAndManagedZone(zoneID string) (string, string) {
 	parts := strings.Split(zoneID, "/")
 	if len(parts) != 2 {
@@ -126,6 +289,132 @@ func (s *dnsClient) projectAndManagedZone(zoneID string) (string, string) {
 	return parts[0], parts[1]
 }
 
+func copyRoutingPolicy(routingPolicy *googledns.RRSetRoutingPolicy) *googledns.RRSetRoutingPolicy {
+	out := &googledns.RRSetRoutingPolicy{}
+	if routingPolicy.Geo != nil {
+		out.Geo = &googledns.RRSetRoutingPolicyGeoPolicy{
+			EnableFencing: routingPolicy.Geo.EnableFencing,
+			Items:         slices.Clone(routingPolicy.Geo.Items),
+		}
+	}
+	if routingPolicy.Wrr != nil {
+		out.Wrr = &googledns.RRSetRoutingPolicyWrrPolicy{
+			Items: slices.Clone(routingPolicy.Wrr.Items),
+		}
+	}
+	return out
+}
+
+// setRoutingPolicyItem sets the rrdatas of the given item in the routing policy and returns whether the routing policy
+// was changed. Missing items in front of the index of a weighted round robin item are filled with placeholders which
+// have weight 0.
+func setRoutingPolicyItem(routingPolicy *googledns.RRSetRoutingPolicy, item RoutingPolicyItem, rrdatas []string) (bool, error) {
+	if len(item.Location) > 0 {
+		if routingPolicy.Wrr != nil {
+			return false, fmt.Errorf("resource recordset already has a weighted round robin routing policy")
+		}
+		if routingPolicy.Geo == nil {
+			routingPolicy.Geo = &googledns.RRSetRoutingPolicyGeoPolicy{}
+		}
+		for i, geoItem := range routingPolicy.Geo.Items {
+			if geoItem.Location == item.Location {
+				if reflect.DeepEqual(geoItem.Rrdatas, rrdatas) {
+					return false, nil
+				}
+				routingPolicy.Geo.Items[i] = &googledns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{Location: item.Location, Rrdatas: rrdatas}
+				return true, nil
+			}
+		}
+		routingPolicy.Geo.Items = append(routingPolicy.Geo.Items, &googledns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{Location: item.Location, Rrdatas: rrdatas})
+		return true, nil
+	}
+
+	if routingPolicy.Geo != nil {
+		return false, fmt.Errorf("resource recordset already has a geolocation routing policy")
+	}
+	if routingPolicy.Wrr == nil {
+		routingPolicy.Wrr = &googledns.RRSetRoutingPolicyWrrPolicy{}
+	}
+	wrrItem := &googledns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{Weight: item.Weight, Rrdatas: rrdatas, ForceSendFields: []string{"Weight"}}
+	switch items := routingPolicy.Wrr.Items; {
+	case item.Index < len(items):
+		if items[item.Index].Weight == item.Weight && reflect.DeepEqual(items[item.Index].Rrdatas, rrdatas) {
+			return false, nil
+		}
+		items[item.Index] = wrrItem
+	default:
+		for len(items) < item.Index {
+			items = append(items, &googledns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{Weight: 0, Rrdatas: rrdatas, ForceSendFields: []string{"Weight"}})
+		}
+		routingPolicy.Wrr.Items = append(items, wrrItem)
+	}
+	return true, nil
+}
+
+// removeRoutingPolicyItem removes the given item from the routing policy and returns whether the routing policy was
+// changed. Items of weighted round robin routing policies are identified by their position, hence only the last item
+// is removed while other items are replaced with placeholders which have weight 0.
+func removeRoutingPolicyItem(routingPolicy *googledns.RRSetRoutingPolicy, item RoutingPolicyItem) bool {
+	if len(item.Location) > 0 {
+		if routingPolicy.Geo == nil {
+			return false
+		}
+		for i, geoItem := range routingPolicy.Geo.Items {
+			if geoItem.Location == item.Location {
+				routingPolicy.Geo.Items = slices.Delete(routingPolicy.Geo.Items, i, i+1)
+				return true
+			}
+		}
+		return false
+	}
+
+	if routingPolicy.Wrr == nil || item.Index >= len(routingPolicy.Wrr.Items) {
+		return false
+	}
+	items := routingPolicy.Wrr.Items
+	if item.Index < len(items)-1 && items[item.Index].Weight == 0 {
+		return false
+	}
+	// The items of other records in front of the removed one must keep their index, hence it is only replaced by a
+	// placeholder with weight 0. Trailing placeholders are removed, together with all items if only placeholders are
+	// left, which deletes the resource recordset.
+	items[item.Index] = &googledns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{Weight: 0, Rrdatas: items[item.Index].Rrdatas, ForceSendFields: []string{"Weight"}}
+	for len(items) > 0 && items[len(items)-1].Weight == 0 {
+		items = items[:len(items)-1]
+	}
+	routingPolicy.Wrr.Items = items
+	return true
+}
+
+// ManagedZoneName derives the name of a managed zone from its DNS name, e.g. example-com for example.com. Names of
+// managed zones must start with a letter, consist of lowercase letters, digits, and dashes, and have at most 63
+// characters. Longer names are shortened and suffixed with a hash of the DNS name to keep them unique.
+func ManagedZoneName(dnsName string) string {
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(normalizeZoneName(dnsName)))
+	if len(name) == 0 || name[0] < 'a' || name[0] > 'z' {
+		name = "zone-" + name
+	}
+	if len(name) > 63 {
+		hash := sha256.Sum256([]byte(normalizeZoneName(dnsName)))
+		name = strings.TrimRight(name[:54], "-") + "-" + hex.EncodeToString(hash[:])[:8]
+	}
+	return strings.TrimRight(name, "-")
+}
+
+// networkURL returns the URL of the VPC network with the given name in the project with the given ID. Networks which
+// are already given by their URLs are returned unchanged.
+func networkURL(projectID, network string) string {
+	if strings.Contains(network, "/") {
+		return network
+	}
+	return fmt.Sprintf("https://www.googleapis.com/compute/v1/projects/%s/global/networks/%s", projectID, network)
+}
+
 func normalizeZoneName(zoneName string) string {
 	if strings.HasPrefix(zoneName, "\\052.") {
 		zoneName = "*" + zoneName[4:]
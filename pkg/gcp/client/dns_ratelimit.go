@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const (
+	// dnsRateLimitQPS is the number of requests per second which are sent to the Cloud DNS API per project.
+	dnsRateLimitQPS = 5
+	// dnsRateLimitBurst is the number of requests which can be sent to the Cloud DNS API per project at once.
+	dnsRateLimitBurst = 10
+	// dnsThrottlingMaxAttempts is the maximum number of attempts of a request which is throttled by the Cloud DNS API.
+	dnsThrottlingMaxAttempts = 5
+	// dnsThrottlingInitialBackoff is the initial backoff of a request which is throttled by the Cloud DNS API.
+	dnsThrottlingInitialBackoff = time.Second
+	// dnsThrottlingMaxBackoff is the maximum backoff of a request which is throttled by the Cloud DNS API.
+	dnsThrottlingMaxBackoff = 30 * time.Second
+)
+
+// dnsRateLimiters are the rate limiters of the requests to the Cloud DNS API, mapped to the projects the requests are
+// sent to. They are shared by all DNS clients, as the quotas of the Cloud DNS API apply per project.
+var dnsRateLimiters = struct {
+	sync.Mutex
+	limiters map[string]flowcontrol.RateLimiter
+}{limiters: map[string]flowcontrol.RateLimiter{}}
+
+// dnsRateLimiter returns the shared rate limiter of the requests to the Cloud DNS API for the project with the given ID.
+func dnsRateLimiter(projectID string) flowcontrol.RateLimiter {
+	dnsRateLimiters.Lock()
+	defer dnsRateLimiters.Unlock()
+
+	limiter, ok := dnsRateLimiters.limiters[projectID]
+	if !ok {
+		limiter = flowcontrol.NewTokenBucketRateLimiter(dnsRateLimitQPS, dnsRateLimitBurst)
+		dnsRateLimiters.limiters[projectID] = limiter
+	}
+	return limiter
+}
+
+// dnsRateLimitTransport is a http.RoundTripper which rate limits the requests to the Cloud DNS API per project and
+// retries requests which are throttled by the API with exponential backoff.
+type dnsRateLimitTransport struct {
+	base           http.RoundTripper
+	initialBackoff time.Duration
+}
+
+func newDNSRateLimitTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &dnsRateLimitTransport{base: base, initialBackoff: dnsThrottlingInitialBackoff}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *dnsRateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	limiter := dnsRateLimiter(projectFromDNSRequestPath(req.URL.Path))
+	backoff := t.initialBackoff
+
+	for attempt := 1; ; attempt++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := t.base.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt == dnsThrottlingMaxAttempts {
+			return resp, err
+		}
+
+		// Requests can only be retried if their body can be sent again.
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+
+		delay := max(wait.Jitter(backoff, 0.5), retryAfter(resp))
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(min(delay, dnsThrottlingMaxBackoff))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		backoff = min(2*backoff, dnsThrottlingMaxBackoff)
+	}
+}
+
+// projectFromDNSRequestPath returns the project of a request to the Cloud DNS API, e.g. my-project for
+// /dns/v1/projects/my-project/managedZones.
+func projectFromDNSRequestPath(path string) string {
+	parts := strings.Split(path, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "projects" {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+// retryAfter returns the delay requested by the Retry-After header of the given response, or 0 if it has none.
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DNS rate limiting", func() {
+	var (
+		server    *httptest.Server
+		requests  atomic.Int32
+		throttled int32
+		bodies    []string
+		client    *http.Client
+	)
+
+	BeforeEach(func() {
+		requests.Store(0)
+		throttled = 0
+		bodies = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			if requests.Add(1) <= throttled {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		client = &http.Client{Transport: &dnsRateLimitTransport{base: http.DefaultTransport, initialBackoff: time.Millisecond}}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should retry throttled requests with their body", func() {
+		throttled = 2
+
+		resp, err := client.Post(server.URL+"/dns/v1/projects/retry/managedZones/zone/changes", "application/json", strings.NewReader(`{"additions":[]}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(requests.Load()).To(Equal(int32(3)))
+		Expect(bodies).To(HaveEach(`{"additions":[]}`))
+	})
+
+	It("should give up after the maximum number of attempts", func() {
+		throttled = dnsThrottlingMaxAttempts + 1
+
+		resp, err := client.Get(server.URL + "/dns/v1/projects/give-up/managedZones")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusTooManyRequests))
+		Expect(requests.Load()).To(Equal(int32(dnsThrottlingMaxAttempts)))
+	})
+
+	It("should share the rate limiter of a project", func() {
+		Expect(dnsRateLimiter("shared")).To(BeIdenticalTo(dnsRateLimiter("shared")))
+		Expect(dnsRateLimiter("shared")).NotTo(BeIdenticalTo(dnsRateLimiter("other")))
+	})
+
+	It("should determine the project of requests", func() {
+		Expect(projectFromDNSRequestPath("/dns/v1/projects/my-project/managedZones/zone/rrsets")).To(Equal("my-project"))
+		Expect(projectFromDNSRequestPath("/dns/v1/projects")).To(BeEmpty())
+	})
+})
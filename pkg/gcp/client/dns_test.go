@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	googledns "google.golang.org/api/dns/v1"
+)
+
+var _ = Describe("DNS routing policies", func() {
+	wrrPolicy := func(weights ...float64) *googledns.RRSetRoutingPolicy {
+		policy := &googledns.RRSetRoutingPolicy{Wrr: &googledns.RRSetRoutingPolicyWrrPolicy{}}
+		for _, weight := range weights {
+			policy.Wrr.Items = append(policy.Wrr.Items, &googledns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{Weight: weight, Rrdatas: []string{"1.2.3.4"}})
+		}
+		return policy
+	}
+
+	weights := func(policy *googledns.RRSetRoutingPolicy) []float64 {
+		var result []float64
+		for _, item := range policy.Wrr.Items {
+			result = append(result, item.Weight)
+		}
+		return result
+	}
+
+	Describe("#setRoutingPolicyItem", func() {
+		It("should append an item with the next index", func() {
+			policy := wrrPolicy(1)
+
+			Expect(setRoutingPolicyItem(policy, RoutingPolicyItem{Index: 1, Weight: 2}, []string{"1.2.3.4"})).To(BeTrue())
+			Expect(weights(policy)).To(Equal([]float64{1, 2}))
+		})
+
+		It("should fill the gap in front of a higher index with placeholders", func() {
+			policy := wrrPolicy(1)
+
+			Expect(setRoutingPolicyItem(policy, RoutingPolicyItem{Index: 3, Weight: 2}, []string{"1.2.3.4"})).To(BeTrue())
+			Expect(weights(policy)).To(Equal([]float64{1, 0, 0, 2}))
+		})
+
+		It("should replace a placeholder", func() {
+			policy := wrrPolicy(0, 2)
+
+			Expect(setRoutingPolicyItem(policy, RoutingPolicyItem{Index: 0, Weight: 1}, []string{"1.2.3.4"})).To(BeTrue())
+			Expect(weights(policy)).To(Equal([]float64{1, 2}))
+		})
+
+		It("should not change the policy if the item is up to date", func() {
+			policy := wrrPolicy(1, 2)
+
+			Expect(setRoutingPolicyItem(policy, RoutingPolicyItem{Index: 1, Weight: 2}, []string{"1.2.3.4"})).To(BeFalse())
+		})
+	})
+
+	Describe("#removeRoutingPolicyItem", func() {
+		It("should replace an item in the middle with a placeholder", func() {
+			policy := wrrPolicy(1, 2, 3)
+
+			Expect(removeRoutingPolicyItem(policy, RoutingPolicyItem{Index: 1})).To(BeTrue())
+			Expect(weights(policy)).To(Equal([]float64{1, 0, 3}))
+		})
+
+		It("should remove the trailing placeholders together with the last item", func() {
+			policy := wrrPolicy(1, 0, 0, 3)
+
+			Expect(removeRoutingPolicyItem(policy, RoutingPolicyItem{Index: 3})).To(BeTrue())
+			Expect(weights(policy)).To(Equal([]float64{1}))
+		})
+
+		It("should remove all items if only placeholders are left", func() {
+			policy := wrrPolicy(0, 2, 0)
+
+			Expect(removeRoutingPolicyItem(policy, RoutingPolicyItem{Index: 1})).To(BeTrue())
+			Expect(policy.Wrr.Items).To(BeEmpty())
+		})
+
+		It("should not change the policy if the item is already a placeholder", func() {
+			policy := wrrPolicy(0, 2)
+
+			Expect(removeRoutingPolicyItem(policy, RoutingPolicyItem{Index: 0})).To(BeFalse())
+			Expect(weights(policy)).To(Equal([]float64{0, 2}))
+		})
+
+		It("should remove a geolocation item", func() {
+			policy := &googledns.RRSetRoutingPolicy{Geo: &googledns.RRSetRoutingPolicyGeoPolicy{Items: []*googledns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{
+				{Location: "europe-west1"},
+				{Location: "us-east1"},
+			}}}
+
+			Expect(removeRoutingPolicyItem(policy, RoutingPolicyItem{Location: "europe-west1"})).To(BeTrue())
+			Expect(policy.Geo.Items).To(ConsistOf(&googledns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{Location: "us-east1"}))
+		})
+	})
+})
+
+var _ = Describe("#ManagedZoneName", func() {
+	It("should derive the name from the DNS name", func() {
+		Expect(ManagedZoneName("example.com.")).To(Equal("example-com"))
+		Expect(ManagedZoneName("1.example.com")).To(Equal("zone-1-example-com"))
+	})
+
+	It("should shorten long names and keep them unique", func() {
+		a := ManagedZoneName("a-very-long-subdomain-name-of-the-shoot.my-project.internal.example.com")
+		b := ManagedZoneName("a-very-long-subdomain-name-of-the-shoot.my-project.internal.example.org")
+
+		Expect(len(a)).To(BeNumerically("<=", 63))
+		Expect(len(b)).To(BeNumerically("<=", 63))
+		Expect(a).NotTo(Equal(b))
+	})
+})
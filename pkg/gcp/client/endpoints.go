@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"net/http"
+
+	"google.golang.org/api/option"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+)
+
+// DefaultAPIEndpoints are the endpoints of the GCP APIs which are used instead of the default ones, e.g. to reach the
+// APIs via Private Service Connect.
+var DefaultAPIEndpoints config.APIEndpoints
+
+// clientOptions returns the options of a client of a GCP API which uses the given HTTP client and the given endpoint,
+// if it is set.
+func clientOptions(httpClient *http.Client, endpoint *string) []option.ClientOption {
+	opts := []option.ClientOption{option.WithHTTPClient(httpClient)}
+	if endpoint != nil && *endpoint != "" {
+		opts = append(opts, option.WithEndpoint(*endpoint))
+	}
+	return opts
+}
@@ -54,6 +54,30 @@ func IsRetentionPolicyNotMetError(err error) bool {
 	return false
 }
 
+// IsResourceInUseError checks if the provided error is a Google API error with the reason "resourceInUseByAnotherResource".
+func IsResourceInUseError(err error) bool {
+	if gErr, ok := err.(*googleapi.Error); ok {
+		for _, e := range gErr.Errors {
+			if e.Reason == "resourceInUseByAnotherResource" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsContainerNotEmptyError checks if the provided error is a Google API error with the reason "containerNotEmpty".
+func IsContainerNotEmptyError(err error) bool {
+	if gErr, ok := err.(*googleapi.Error); ok {
+		for _, e := range gErr.Errors {
+			if e.Reason == "containerNotEmpty" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // IgnoreNotFoundError returns nil if the error is a NotFound error. Otherwise, it returns the original error.
 func IgnoreNotFoundError(err error) error {
 	return IgnoreErrorCodes(err, http.StatusNotFound)
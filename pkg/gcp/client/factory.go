@@ -10,7 +10,6 @@ import (
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
-	"golang.org/x/oauth2/google/externalaccount"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -43,62 +42,62 @@ func New() Factory {
 
 // DNS returns a GCP cloud DNS service client.
 func (f factory) DNS(ctx context.Context, c client.Client, sr corev1.SecretReference) (DNSClient, error) {
-	credentialsConfig, err := gcp.GetCredentialsConfigFromSecretReference(ctx, c, sr)
-	if err != nil {
-		return nil, err
-	}
-
-	return NewDNSClient(ctx, credentialsConfig)
+	return cachedClient(ctx, c, sr, serviceDNS, NewDNSClient)
 }
 
 // Storage reads the secret from the passed reference and returns a GCP (blob) storage client.
 func (f factory) Storage(ctx context.Context, c client.Client, sr corev1.SecretReference) (StorageClient, error) {
-	credentialsConfig, err := gcp.GetCredentialsConfigFromSecretReference(ctx, c, sr)
-	if err != nil {
-		return nil, err
-	}
-
-	return NewStorageClient(ctx, credentialsConfig)
+	return cachedClient(ctx, c, sr, serviceStorage, NewStorageClient)
 }
 
 // Compute reads the secret from the passed reference and returns a GCP compute client.
 func (f factory) Compute(ctx context.Context, c client.Client, sr corev1.SecretReference) (ComputeClient, error) {
-	credentialsConfig, err := gcp.GetCredentialsConfigFromSecretReference(ctx, c, sr)
-	if err != nil {
-		return nil, err
-	}
-
-	return NewComputeClient(ctx, credentialsConfig)
+	return cachedClient(ctx, c, sr, serviceCompute, NewComputeClient)
 }
 
 // IAM reads the secret from the passed reference and returns a GCP compute client.
 func (f factory) IAM(ctx context.Context, c client.Client, sr corev1.SecretReference) (IAMClient, error) {
-	credentialsConfig, err := gcp.GetCredentialsConfigFromSecretReference(ctx, c, sr)
+	return cachedClient(ctx, c, sr, serviceIAM, NewIAMClient)
+}
+
+func httpClient(ctx context.Context, credentialsConfig *gcp.CredentialsConfig, scopes []string) (*http.Client, error) {
+	ctx = withBaseHTTPClient(ctx)
+
+	ts, err := tokenSource(ctx, credentialsConfig, scopes)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewIAMClient(ctx, credentialsConfig)
+	return oauth2Client(ctx, ts, credentialsConfig.QuotaProject), nil
 }
 
-func httpClient(ctx context.Context, credentialsConfig *gcp.CredentialsConfig, scopes []string) (*http.Client, error) {
+// oauth2Client returns a HTTP client which authenticates its requests with the given token source. The requests are
+// billed to the given quota project, if it is set.
+func oauth2Client(ctx context.Context, ts oauth2.TokenSource, quotaProject string) *http.Client {
+	client := oauth2.NewClient(ctx, ts)
+	if quotaProject != "" {
+		client.Transport = &quotaProjectTransport{base: client.Transport, quotaProject: quotaProject}
+	}
+	return client
+}
+
+// quotaProjectTransport is a http.RoundTripper which sets the project used for the quota and billing of the requests.
+type quotaProjectTransport struct {
+	base         http.RoundTripper
+	quotaProject string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *quotaProjectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A http.RoundTripper must not modify the given request.
+	req = req.Clone(req.Context())
+	req.Header.Set("X-Goog-User-Project", t.quotaProject)
+	return t.base.RoundTrip(req)
+}
+
+func tokenSource(ctx context.Context, credentialsConfig *gcp.CredentialsConfig, scopes []string) (oauth2.TokenSource, error) {
 	if credentialsConfig.TokenRetriever != nil && credentialsConfig.Type == gcp.ExternalAccountCredentialType {
-		conf := externalaccount.Config{
-			Audience:                       credentialsConfig.Audience,
-			SubjectTokenType:               credentialsConfig.SubjectTokenType,
-			TokenURL:                       credentialsConfig.TokenURL,
-			Scopes:                         scopes,
-			SubjectTokenSupplier:           credentialsConfig.TokenRetriever,
-			UniverseDomain:                 credentialsConfig.UniverseDomain,
-			ServiceAccountImpersonationURL: credentialsConfig.ServiceAccountImpersonationURL,
-		}
-
-		ts, err := externalaccount.NewTokenSource(ctx, conf)
-		if err != nil {
-			return nil, err
-		}
-
-		return oauth2.NewClient(ctx, ts), nil
+		return externalAccountTokenSource(credentialsConfig, scopes)
 	}
 
 	credentials, err := google.CredentialsFromJSONWithParams(ctx, credentialsConfig.Raw, google.CredentialsParams{
@@ -108,5 +107,5 @@ func httpClient(ctx context.Context, credentialsConfig *gcp.CredentialsConfig, s
 		return nil, err
 	}
 
-	return oauth2.NewClient(ctx, credentials.TokenSource), nil
+	return credentials.TokenSource, nil
 }
@@ -0,0 +1,710 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"sync"
+
+	"google.golang.org/api/compute/v1"
+
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+var _ gcpclient.ComputeClient = &ComputeClient{}
+
+// ComputeClient is an in-memory fake of gcpclient.ComputeClient. Operations complete immediately. Server side filters
+// of list calls are not evaluated, only client side filters are applied.
+type ComputeClient struct {
+	Behavior
+
+	mu        sync.Mutex
+	projectID string
+	nextID    uint64
+
+	instances       map[string]*compute.Instance
+	disks           map[string]*compute.Disk
+	storagePools    map[string]*compute.StoragePool
+	networks        map[string]*compute.Network
+	subnets         map[string]*compute.Subnetwork
+	routers         map[string]*compute.Router
+	routes          map[string]*compute.Route
+	firewalls       map[string]*compute.Firewall
+	addresses       map[string]*compute.Address
+	forwardingRules map[string]*compute.ForwardingRule
+	machineTypes    map[string]*compute.MachineType
+	regions         map[string]*compute.Region
+	images          map[string][]*compute.Image
+}
+
+// NewComputeClient returns a new empty ComputeClient for the project with the given ID.
+func NewComputeClient(projectID string) *ComputeClient {
+	return &ComputeClient{
+		projectID:       projectID,
+		instances:       map[string]*compute.Instance{},
+		disks:           map[string]*compute.Disk{},
+		storagePools:    map[string]*compute.StoragePool{},
+		networks:        map[string]*compute.Network{},
+		subnets:         map[string]*compute.Subnetwork{},
+		routers:         map[string]*compute.Router{},
+		routes:          map[string]*compute.Route{},
+		firewalls:       map[string]*compute.Firewall{},
+		addresses:       map[string]*compute.Address{},
+		forwardingRules: map[string]*compute.ForwardingRule{},
+		machineTypes:    map[string]*compute.MachineType{},
+		regions:         map[string]*compute.Region{},
+		images:          map[string][]*compute.Image{},
+	}
+}
+
+func (c *ComputeClient) selfLink(scope, kind, name string) string {
+	return fmt.Sprintf("https://www.googleapis.com/compute/v1/projects/%s/%s", c.projectID, path.Join(scope, kind, name))
+}
+
+func (c *ComputeClient) id() uint64 {
+	c.nextID++
+	return c.nextID
+}
+
+// AddAddress adds the given Address to the given region.
+func (c *ComputeClient) AddAddress(region string, address *compute.Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.addresses[path.Join(region, address.Name)] = clone(address)
+}
+
+// AddRoute adds the given Route.
+func (c *ComputeClient) AddRoute(route *compute.Route) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.routes[route.Name] = clone(route)
+}
+
+// AddForwardingRule adds the given ForwardingRule to the given region, or as a global one if the region is empty.
+func (c *ComputeClient) AddForwardingRule(region string, rule *compute.ForwardingRule) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.forwardingRules[path.Join(region, rule.Name)] = clone(rule)
+}
+
+// AddMachineType adds the given MachineType to the given zone.
+func (c *ComputeClient) AddMachineType(zone string, machineType *compute.MachineType) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.machineTypes[path.Join(zone, machineType.Name)] = clone(machineType)
+}
+
+// AddRegion adds the given Region. Its zones are returned by ListZones.
+func (c *ComputeClient) AddRegion(region *compute.Region) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.regions[region.Name] = clone(region)
+}
+
+// AddImages adds the given Images, which are returned by ListImages for the given image name.
+func (c *ComputeClient) AddImages(imageName string, images ...*compute.Image) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, image := range images {
+		c.images[imageName] = append(c.images[imageName], clone(image))
+	}
+}
+
+// GetExternalAddresses returns a list of all external IP addresses mapped to the names of their users.
+func (c *ComputeClient) GetExternalAddresses(ctx context.Context, region string) (map[string][]string, error) {
+	if err := c.call(ctx, "GetExternalAddresses"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	addresses := map[string][]string{}
+	for key, address := range c.addresses {
+		if path.Dir(key) != region || address.AddressType != "EXTERNAL" {
+			continue
+		}
+		var userNames []string
+		if address.Status == "IN_USE" {
+			for _, user := range address.Users {
+				userNames = append(userNames, path.Base(user))
+			}
+		}
+		addresses[address.Name] = userNames
+	}
+	return addresses, nil
+}
+
+// GetAddress returns the Address specified by region and name. Returns nil if the Address is not found.
+func (c *ComputeClient) GetAddress(ctx context.Context, region, name string) (*compute.Address, error) {
+	if err := c.call(ctx, "GetAddress"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return clone(c.addresses[path.Join(region, name)]), nil
+}
+
+// GetInstance returns the Instance specified by zone and name.
+func (c *ComputeClient) GetInstance(ctx context.Context, zone, instanceName string) (*compute.Instance, error) {
+	if err := c.call(ctx, "GetInstance"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	instance, ok := c.instances[path.Join(zone, instanceName)]
+	if !ok {
+		return nil, notFoundError("instances", instanceName)
+	}
+	return clone(instance), nil
+}
+
+// InsertInstance creates a new Instance with the given specification.
+func (c *ComputeClient) InsertInstance(ctx context.Context, zone string, instance *compute.Instance) (*compute.Instance, error) {
+	if err := c.call(ctx, "InsertInstance"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := path.Join(zone, instance.Name)
+	if _, ok := c.instances[key]; ok {
+		return nil, alreadyExistsError("instances", instance.Name)
+	}
+	instance = clone(instance)
+	instance.Id = c.id()
+	instance.Zone = c.selfLink(path.Join("zones", zone), "", "")
+	instance.SelfLink = c.selfLink(path.Join("zones", zone), "instances", instance.Name)
+	instance.Status = "RUNNING"
+	c.instances[key] = instance
+	return clone(instance), nil
+}
+
+// DeleteInstance deletes the Instance. Returns no error if the Instance is not found.
+func (c *ComputeClient) DeleteInstance(ctx context.Context, zone, instanceName string) error {
+	if err := c.call(ctx, "DeleteInstance"); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.instances, path.Join(zone, instanceName))
+	return nil
+}
+
+// GetDisk returns the Disk specified by zone and name.
+func (c *ComputeClient) GetDisk(ctx context.Context, zone, diskName string) (*compute.Disk, error) {
+	if err := c.call(ctx, "GetDisk"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	disk, ok := c.disks[path.Join(zone, diskName)]
+	if !ok {
+		return nil, notFoundError("disks", diskName)
+	}
+	return clone(disk), nil
+}
+
+// InsertDisk creates a new Disk with the given specification.
+func (c *ComputeClient) InsertDisk(ctx context.Context, zone string, disk *compute.Disk) (*compute.Disk, error) {
+	if err := c.call(ctx, "InsertDisk"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := path.Join(zone, disk.Name)
+	if _, ok := c.disks[key]; ok {
+		return nil, alreadyExistsError("disks", disk.Name)
+	}
+	disk = clone(disk)
+	disk.Id = c.id()
+	disk.SelfLink = c.selfLink(path.Join("zones", zone), "disks", disk.Name)
+	disk.Status = "READY"
+	c.disks[key] = disk
+	return clone(disk), nil
+}
+
+// DeleteDisk deletes the Disk. Returns no error if the Disk is not found.
+func (c *ComputeClient) DeleteDisk(ctx context.Context, zone, diskName string) error {
+	if err := c.call(ctx, "DeleteDisk"); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.disks, path.Join(zone, diskName))
+	return nil
+}
+
+// GetStoragePool returns the StoragePool specified by zone and name. Returns nil if the StoragePool is not found.
+func (c *ComputeClient) GetStoragePool(ctx context.Context, zone, name string) (*compute.StoragePool, error) {
+	if err := c.call(ctx, "GetStoragePool"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return clone(c.storagePools[path.Join(zone, name)]), nil
+}
+
+// InsertStoragePool creates a new StoragePool with the given specification.
+func (c *ComputeClient) InsertStoragePool(ctx context.Context, zone string, pool *compute.StoragePool) (*compute.StoragePool, error) {
+	if err := c.call(ctx, "InsertStoragePool"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := path.Join(zone, pool.Name)
+	if _, ok := c.storagePools[key]; ok {
+		return nil, alreadyExistsError("storagePools", pool.Name)
+	}
+	pool = clone(pool)
+	pool.Id = c.id()
+	pool.Zone = c.selfLink(path.Join("zones", zone), "", "")
+	pool.SelfLink = c.selfLink(path.Join("zones", zone), "storagePools", pool.Name)
+	c.storagePools[key] = pool
+	return clone(pool), nil
+}
+
+// UpdateStoragePool updates the provisioned capacity, IOPS and throughput of the StoragePool.
+func (c *ComputeClient) UpdateStoragePool(ctx context.Context, zone, name string, pool *compute.StoragePool) (*compute.StoragePool, error) {
+	if err := c.call(ctx, "UpdateStoragePool"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	existing, ok := c.storagePools[path.Join(zone, name)]
+	if !ok {
+		return nil, notFoundError("storagePools", name)
+	}
+	existing.PoolProvisionedCapacityGb = pool.PoolProvisionedCapacityGb
+	existing.PoolProvisionedIops = pool.PoolProvisionedIops
+	existing.PoolProvisionedThroughput = pool.PoolProvisionedThroughput
+	return clone(existing), nil
+}
+
+// DeleteStoragePool deletes the StoragePool. Returns no error if the StoragePool is not found.
+func (c *ComputeClient) DeleteStoragePool(ctx context.Context, zone, name string) error {
+	if err := c.call(ctx, "DeleteStoragePool"); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.storagePools, path.Join(zone, name))
+	return nil
+}
+
+// ListStoragePools lists the StoragePools of all zones.
+func (c *ComputeClient) ListStoragePools(ctx context.Context, _ gcpclient.StoragePoolListOpts) ([]*compute.StoragePool, error) {
+	if err := c.call(ctx, "ListStoragePools"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return list(c.storagePools, nil), nil
+}
+
+// InsertNetwork creates a Network with the given specification.
+func (c *ComputeClient) InsertNetwork(ctx context.Context, nw *compute.Network) (*compute.Network, error) {
+	if err := c.call(ctx, "InsertNetwork"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.networks[nw.Name]; ok {
+		return nil, alreadyExistsError("networks", nw.Name)
+	}
+	nw = clone(nw)
+	nw.Id = c.id()
+	nw.SelfLink = c.selfLink("global", "networks", nw.Name)
+	c.networks[nw.Name] = nw
+	return clone(nw), nil
+}
+
+// GetNetwork returns the Network specified by id. Returns nil if the Network is not found.
+func (c *ComputeClient) GetNetwork(ctx context.Context, id string) (*compute.Network, error) {
+	if err := c.call(ctx, "GetNetwork"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return clone(c.networks[id]), nil
+}
+
+// DeleteNetwork deletes the Network. Returns no error if the Network is not found.
+func (c *ComputeClient) DeleteNetwork(ctx context.Context, id string) error {
+	if err := c.call(ctx, "DeleteNetwork"); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, subnet := range c.subnets {
+		if path.Base(subnet.Network) == id {
+			return NewAPIError(http.StatusBadRequest, "resourceInUseByAnotherResource", fmt.Sprintf("The network resource '%s' is already being used by '%s'", id, subnet.Name))
+		}
+	}
+	delete(c.networks, id)
+	return nil
+}
+
+// PatchNetwork patches the Network specified by id with the given specification.
+func (c *ComputeClient) PatchNetwork(ctx context.Context, id string, nw *compute.Network) (*compute.Network, error) {
+	if err := c.call(ctx, "PatchNetwork"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	existing, ok := c.networks[id]
+	if !ok {
+		return nil, notFoundError("networks", id)
+	}
+	c.networks[id] = patch(existing, nw)
+	return clone(c.networks[id]), nil
+}
+
+// InsertSubnet creates a Subnetwork with the given specification.
+func (c *ComputeClient) InsertSubnet(ctx context.Context, region string, subnet *compute.Subnetwork) (*compute.Subnetwork, error) {
+	if err := c.call(ctx, "InsertSubnet"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := path.Join(region, subnet.Name)
+	if _, ok := c.subnets[key]; ok {
+		return nil, alreadyExistsError("subnetworks", subnet.Name)
+	}
+	subnet = clone(subnet)
+	subnet.Id = c.id()
+	subnet.Region = c.selfLink(path.Join("regions", region), "", "")
+	subnet.SelfLink = c.selfLink(path.Join("regions", region), "subnetworks", subnet.Name)
+	c.subnets[key] = subnet
+	return clone(subnet), nil
+}
+
+// GetSubnet returns the Subnetwork specified by id. Returns nil if the Subnetwork is not found.
+func (c *ComputeClient) GetSubnet(ctx context.Context, region, id string) (*compute.Subnetwork, error) {
+	if err := c.call(ctx, "GetSubnet"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return clone(c.subnets[path.Join(region, id)]), nil
+}
+
+// PatchSubnet updates the Subnetwork specified by id with the given specification.
+func (c *ComputeClient) PatchSubnet(ctx context.Context, region, id string, subnet *compute.Subnetwork) (*compute.Subnetwork, error) {
+	if err := c.call(ctx, "PatchSubnet"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := path.Join(region, id)
+	existing, ok := c.subnets[key]
+	if !ok {
+		return nil, notFoundError("subnetworks", id)
+	}
+	c.subnets[key] = patch(existing, subnet)
+	return clone(c.subnets[key]), nil
+}
+
+// DeleteSubnet deletes the Subnetwork specified by id. Returns no error if the Subnetwork is not found.
+func (c *ComputeClient) DeleteSubnet(ctx context.Context, region, id string) error {
+	if err := c.call(ctx, "DeleteSubnet"); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.subnets, path.Join(region, id))
+	return nil
+}
+
+// ExpandSubnet expands the Subnetwork to the target CIDR.
+func (c *ComputeClient) ExpandSubnet(ctx context.Context, region, id, cidr string) (*compute.Subnetwork, error) {
+	if err := c.call(ctx, "ExpandSubnet"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	subnet, ok := c.subnets[path.Join(region, id)]
+	if !ok {
+		return nil, notFoundError("subnetworks", id)
+	}
+	subnet.IpCidrRange = cidr
+	return clone(subnet), nil
+}
+
+// WaitForIPv6Cidr returns the external IPv6 prefix of the Subnetwork. Unlike the real client, it does not wait for
+// the prefix to be assigned.
+func (c *ComputeClient) WaitForIPv6Cidr(ctx context.Context, region, subnetID string) (string, error) {
+	if err := c.call(ctx, "WaitForIPv6Cidr"); err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	subnet, ok := c.subnets[path.Join(region, subnetID)]
+	if !ok || subnet.ExternalIpv6Prefix == "" {
+		return "", fmt.Errorf("no IPv6 CIDR block was assigned to subnet %q", subnetID)
+	}
+	return subnet.ExternalIpv6Prefix, nil
+}
+
+// InsertRouter creates a Router with the given specification.
+func (c *ComputeClient) InsertRouter(ctx context.Context, region string, router *compute.Router) (*compute.Router, error) {
+	if err := c.call(ctx, "InsertRouter"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := path.Join(region, router.Name)
+	if _, ok := c.routers[key]; ok {
+		return nil, alreadyExistsError("routers", router.Name)
+	}
+	router = clone(router)
+	router.Id = c.id()
+	router.Region = c.selfLink(path.Join("regions", region), "", "")
+	router.SelfLink = c.selfLink(path.Join("regions", region), "routers", router.Name)
+	c.routers[key] = router
+	return clone(router), nil
+}
+
+// GetRouter returns the Router specified by id. Returns nil if the Router is not found.
+func (c *ComputeClient) GetRouter(ctx context.Context, region, id string) (*compute.Router, error) {
+	if err := c.call(ctx, "GetRouter"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return clone(c.routers[path.Join(region, id)]), nil
+}
+
+// PatchRouter updates the Router specified by id with the given specification.
+func (c *ComputeClient) PatchRouter(ctx context.Context, region, id string, router *compute.Router) (*compute.Router, error) {
+	if err := c.call(ctx, "PatchRouter"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := path.Join(region, id)
+	existing, ok := c.routers[key]
+	if !ok {
+		return nil, notFoundError("routers", id)
+	}
+	c.routers[key] = patch(existing, router)
+	return clone(c.routers[key]), nil
+}
+
+// DeleteRouter deletes the Router specified by id. Returns no error if the Router is not found.
+func (c *ComputeClient) DeleteRouter(ctx context.Context, region, id string) error {
+	if err := c.call(ctx, "DeleteRouter"); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.routers, path.Join(region, id))
+	return nil
+}
+
+// ListRoutes lists all Routes matching the client side filter of the given options.
+func (c *ComputeClient) ListRoutes(ctx context.Context, opts gcpclient.RouteListOpts) ([]*compute.Route, error) {
+	if err := c.call(ctx, "ListRoutes"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return list(c.routes, opts.ClientFilter), nil
+}
+
+// DeleteRoute deletes the specified Route. Like the real client, it returns an error if the Route is not found.
+func (c *ComputeClient) DeleteRoute(ctx context.Context, id string) error {
+	if err := c.call(ctx, "DeleteRoute"); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.routes[id]; !ok {
+		return notFoundError("routes", id)
+	}
+	delete(c.routes, id)
+	return nil
+}
+
+// InsertFirewallRule creates a firewall rule with the given specification.
+func (c *ComputeClient) InsertFirewallRule(ctx context.Context, firewall *compute.Firewall) (*compute.Firewall, error) {
+	if err := c.call(ctx, "InsertFirewallRule"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.firewalls[firewall.Name]; ok {
+		return nil, alreadyExistsError("firewalls", firewall.Name)
+	}
+	firewall = clone(firewall)
+	firewall.Id = c.id()
+	firewall.SelfLink = c.selfLink("global", "firewalls", firewall.Name)
+	c.firewalls[firewall.Name] = firewall
+	return clone(firewall), nil
+}
+
+// GetFirewallRule returns the firewall rule specified by name. Returns nil if the firewall rule is not found.
+func (c *ComputeClient) GetFirewallRule(ctx context.Context, firewall string) (*compute.Firewall, error) {
+	if err := c.call(ctx, "GetFirewallRule"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return clone(c.firewalls[firewall]), nil
+}
+
+// PatchFirewallRule updates the firewall rule specified by name with the given specification.
+func (c *ComputeClient) PatchFirewallRule(ctx context.Context, name string, firewall *compute.Firewall) (*compute.Firewall, error) {
+	if err := c.call(ctx, "PatchFirewallRule"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	existing, ok := c.firewalls[name]
+	if !ok {
+		return nil, notFoundError("firewalls", name)
+	}
+	c.firewalls[name] = patch(existing, firewall)
+	return clone(c.firewalls[name]), nil
+}
+
+// DeleteFirewallRule deletes the firewall rule specified by name. Returns no error if the firewall rule is not found.
+func (c *ComputeClient) DeleteFirewallRule(ctx context.Context, firewall string) error {
+	if err := c.call(ctx, "DeleteFirewallRule"); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.firewalls, firewall)
+	return nil
+}
+
+// ListFirewallRules lists all firewall rules matching the client side filter of the given options.
+func (c *ComputeClient) ListFirewallRules(ctx context.Context, opts gcpclient.FirewallListOpts) ([]*compute.Firewall, error) {
+	if err := c.call(ctx, "ListFirewallRules"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return list(c.firewalls, opts.ClientFilter), nil
+}
+
+// ListImages lists the Images which were added for the given image name. The order and fields are ignored.
+func (c *ComputeClient) ListImages(ctx context.Context, imageName, _, _ string) (*compute.ImageList, error) {
+	if err := c.call(ctx, "ListImages"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.images[imageName]) == 0 {
+		return nil, fmt.Errorf("no available image with name %s found", imageName)
+	}
+	imageList := &compute.ImageList{}
+	for _, image := range c.images[imageName] {
+		imageList.Items = append(imageList.Items, clone(image))
+	}
+	return imageList, nil
+}
+
+// GetRegion returns the Region specified.
+func (c *ComputeClient) GetRegion(ctx context.Context, region string) (*compute.Region, error) {
+	if err := c.call(ctx, "GetRegion"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r, ok := c.regions[region]
+	if !ok {
+		return nil, notFoundError("regions", region)
+	}
+	return clone(r), nil
+}
+
+// ListZones returns the names of the zones of the Region specified.
+func (c *ComputeClient) ListZones(ctx context.Context, region string) ([]string, error) {
+	if err := c.call(ctx, "ListZones"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r, ok := c.regions[region]
+	if !ok {
+		return nil, notFoundError("regions", region)
+	}
+	zones := make([]string, 0, len(r.Zones))
+	for _, zone := range r.Zones {
+		zones = append(zones, path.Base(zone))
+	}
+	return zones, nil
+}
+
+// GetMachineType returns the MachineType specified by zone and name. Returns nil if the MachineType is not found.
+func (c *ComputeClient) GetMachineType(ctx context.Context, zone, name string) (*compute.MachineType, error) {
+	if err := c.call(ctx, "GetMachineType"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return clone(c.machineTypes[path.Join(zone, name)]), nil
+}
+
+// GetForwardingRule returns the ForwardingRule specified by region and name. The ForwardingRule is a global one if
+// the region is empty. Returns nil if the ForwardingRule is not found.
+func (c *ComputeClient) GetForwardingRule(ctx context.Context, region, name string) (*compute.ForwardingRule, error) {
+	if err := c.call(ctx, "GetForwardingRule"); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return clone(c.forwardingRules[path.Join(region, name)]), nil
+}
+
+// list returns copies of the items of the given map which match the given filter, sorted by their keys.
+func list[T any](items map[string]*T, filter func(*T) bool) []*T {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var res []*T
+	for _, key := range keys {
+		item := clone(items[key])
+		if filter != nil && !filter(item) {
+			continue
+		}
+		res = append(res, item)
+	}
+	return res
+}
@@ -0,0 +1,272 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+var _ gcpclient.DNSClient = &DNSClient{}
+
+// RecordSet is a resource recordset of the fake DNS client.
+type RecordSet struct {
+	// Rrdatas are the rrdatas of a recordset without routing policy.
+	Rrdatas []string
+	// TTL is the TTL of the recordset in seconds.
+	TTL int64
+	// RoutingPolicy maps the items of the routing policy of the recordset to their rrdatas.
+	RoutingPolicy map[gcpclient.RoutingPolicyItem][]string
+}
+
+type managedZone struct {
+	spec       gcpclient.ManagedZoneSpec
+	recordSets map[string]*RecordSet
+}
+
+// DNSClient is an in-memory fake of gcpclient.DNSClient. The IDs of its managed zones are composed of the project ID
+// and the name of the managed zone, like the ones of the real client.
+type DNSClient struct {
+	Behavior
+
+	mu           sync.Mutex
+	projectID    string
+	managedZones map[string]*managedZone
+}
+
+// NewDNSClient returns a new empty DNSClient for the project with the given ID.
+func NewDNSClient(projectID string) *DNSClient {
+	return &DNSClient{
+		projectID:    projectID,
+		managedZones: map[string]*managedZone{},
+	}
+}
+
+// RecordSet returns a copy of the resource recordset with the given name and record type in the managed zone with the
+// given name or ID, or nil if it does not exist.
+func (d *DNSClient) RecordSet(managedZone, name, recordType string) *RecordSet {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	zone, ok := d.managedZones[d.managedZoneID(managedZone)]
+	if !ok {
+		return nil
+	}
+	if rrs, ok := zone.recordSets[recordSetKey(name, recordType)]; ok {
+		return copyRecordSet(rrs)
+	}
+	return nil
+}
+
+// GetManagedZones returns a map of all managed zone DNS names mapped to their IDs in the project with the given ID, or
+// in the project of the client if it is empty.
+func (d *DNSClient) GetManagedZones(ctx context.Context, projectID string) (map[string]string, error) {
+	if err := d.call(ctx, "GetManagedZones"); err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(projectID) == 0 {
+		projectID = d.projectID
+	}
+	zones := map[string]string{}
+	for id, zone := range d.managedZones {
+		if strings.HasPrefix(id, projectID+"/") {
+			zones[strings.TrimSuffix(zone.spec.DNSName, ".")] = id
+		}
+	}
+	return zones, nil
+}
+
+// CreateManagedZone creates a managed zone with the given specification in the project with the given ID, or in the
+// project of the client if it is empty, and returns its ID. If the managed zone already exists with the same DNS name,
+// its ID is returned.
+func (d *DNSClient) CreateManagedZone(ctx context.Context, projectID string, spec gcpclient.ManagedZoneSpec) (string, error) {
+	if err := d.call(ctx, "CreateManagedZone"); err != nil {
+		return "", err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(projectID) == 0 {
+		projectID = d.projectID
+	}
+	id := projectID + "/" + gcpclient.ManagedZoneName(spec.DNSName)
+	if zone, ok := d.managedZones[id]; ok {
+		if strings.TrimSuffix(zone.spec.DNSName, ".") != strings.TrimSuffix(spec.DNSName, ".") {
+			return "", fmt.Errorf("managed zone %s already exists with DNS name %s", id, zone.spec.DNSName)
+		}
+		return id, nil
+	}
+	spec.Networks = slices.Clone(spec.Networks)
+	d.managedZones[id] = &managedZone{spec: spec, recordSets: map[string]*RecordSet{}}
+	return id, nil
+}
+
+// DeleteManagedZone deletes the managed zone with the given name or ID if it does not contain any resource recordsets.
+func (d *DNSClient) DeleteManagedZone(ctx context.Context, managedZone string) error {
+	if err := d.call(ctx, "DeleteManagedZone"); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	id := d.managedZoneID(managedZone)
+	if zone, ok := d.managedZones[id]; ok && len(zone.recordSets) == 0 {
+		delete(d.managedZones, id)
+	}
+	return nil
+}
+
+// CreateOrUpdateRecordSet creates or updates the resource recordset with the given name, record type, rrdatas, and ttl
+// in the managed zone with the given name or ID.
+func (d *DNSClient) CreateOrUpdateRecordSet(ctx context.Context, managedZone, name, recordType string, rrdatas []string, ttl int64) error {
+	if err := d.call(ctx, "CreateOrUpdateRecordSet"); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	zone, err := d.managedZone(managedZone)
+	if err != nil {
+		return err
+	}
+	zone.recordSets[recordSetKey(name, recordType)] = &RecordSet{Rrdatas: formatRrdatas(recordType, rrdatas), TTL: ttl}
+	return nil
+}
+
+// DeleteRecordSet deletes the resource recordset with the given name and record type in the managed zone with the given
+// name or ID.
+func (d *DNSClient) DeleteRecordSet(ctx context.Context, managedZone, name, recordType string) error {
+	if err := d.call(ctx, "DeleteRecordSet"); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	zone, err := d.managedZone(managedZone)
+	if err != nil {
+		return err
+	}
+	delete(zone.recordSets, recordSetKey(name, recordType))
+	return nil
+}
+
+// CreateOrUpdateRoutingPolicyItem creates or updates the given item of the routing policy of the resource recordset with
+// the given name and record type in the managed zone with the given name or ID.
+func (d *DNSClient) CreateOrUpdateRoutingPolicyItem(ctx context.Context, managedZone, name, recordType string, item gcpclient.RoutingPolicyItem, rrdatas []string, ttl int64) error {
+	if err := d.call(ctx, "CreateOrUpdateRoutingPolicyItem"); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	zone, err := d.managedZone(managedZone)
+	if err != nil {
+		return err
+	}
+	key := recordSetKey(name, recordType)
+	rrs, ok := zone.recordSets[key]
+	if !ok || rrs.RoutingPolicy == nil {
+		rrs = &RecordSet{RoutingPolicy: map[gcpclient.RoutingPolicyItem][]string{}}
+		zone.recordSets[key] = rrs
+	}
+	for existing := range rrs.RoutingPolicy {
+		if sameRoutingPolicyItem(existing, item) {
+			delete(rrs.RoutingPolicy, existing)
+		}
+	}
+	rrs.RoutingPolicy[item] = formatRrdatas(recordType, rrdatas)
+	rrs.TTL = ttl
+	return nil
+}
+
+// DeleteRoutingPolicyItem deletes the given item of the routing policy of the resource recordset with the given name
+// and record type in the managed zone with the given name or ID. The resource recordset is deleted together with its
+// last item.
+func (d *DNSClient) DeleteRoutingPolicyItem(ctx context.Context, managedZone, name, recordType string, item gcpclient.RoutingPolicyItem) error {
+	if err := d.call(ctx, "DeleteRoutingPolicyItem"); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	zone, err := d.managedZone(managedZone)
+	if err != nil {
+		return err
+	}
+	key := recordSetKey(name, recordType)
+	rrs, ok := zone.recordSets[key]
+	if !ok || rrs.RoutingPolicy == nil {
+		return nil
+	}
+	for existing := range rrs.RoutingPolicy {
+		if sameRoutingPolicyItem(existing, item) {
+			delete(rrs.RoutingPolicy, existing)
+		}
+	}
+	if len(rrs.RoutingPolicy) == 0 {
+		delete(zone.recordSets, key)
+	}
+	return nil
+}
+
+func (d *DNSClient) managedZoneID(managedZone string) string {
+	if strings.Contains(managedZone, "/") {
+		return managedZone
+	}
+	return d.projectID + "/" + managedZone
+}
+
+func (d *DNSClient) managedZone(managedZone string) (*managedZone, error) {
+	zone, ok := d.managedZones[d.managedZoneID(managedZone)]
+	if !ok {
+		return nil, notFoundError("managedZones", managedZone)
+	}
+	return zone, nil
+}
+
+// sameRoutingPolicyItem returns true if the given items denote the same item of a routing policy, i.e. they have the
+// same location or, for weighted round robin routing policies, the same index.
+func sameRoutingPolicyItem(a, b gcpclient.RoutingPolicyItem) bool {
+	if len(a.Location) > 0 || len(b.Location) > 0 {
+		return a.Location == b.Location
+	}
+	return a.Index == b.Index
+}
+
+func recordSetKey(name, recordType string) string {
+	return strings.TrimSuffix(name, ".") + "/" + recordType
+}
+
+func formatRrdatas(recordType string, values []string) []string {
+	rrdatas := slices.Clone(values)
+	if recordType == "CNAME" {
+		for i, value := range rrdatas {
+			if !strings.HasSuffix(value, ".") {
+				rrdatas[i] = value + "."
+			}
+		}
+	}
+	return rrdatas
+}
+
+func copyRecordSet(in *RecordSet) *RecordSet {
+	out := &RecordSet{Rrdatas: slices.Clone(in.Rrdatas), TTL: in.TTL}
+	if in.RoutingPolicy != nil {
+		out.RoutingPolicy = maps.Clone(in.RoutingPolicy)
+		for item, rrdatas := range out.RoutingPolicy {
+			out.RoutingPolicy[item] = slices.Clone(rrdatas)
+		}
+	}
+	return out
+}
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fake contains in-memory fakes of the GCP clients, which can be used in tests instead of real GCP
+// credentials. Failures and latencies of their calls can be injected via their Behavior.
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// AnyMethod matches all methods of a fake client when injecting failures or latencies.
+const AnyMethod = "*"
+
+// Behavior configures the failures and latencies which are injected into the calls of a fake client and records the
+// number of calls. Methods are identified by their names, e.g. "InsertNetwork". The zero value injects nothing.
+type Behavior struct {
+	mu        sync.Mutex
+	errors    map[string]error
+	latencies map[string]time.Duration
+	calls     map[string]int
+}
+
+// FailWith lets all following calls of the given method fail with the given error. A nil error removes the failure.
+func (b *Behavior) FailWith(method string, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.errors == nil {
+		b.errors = map[string]error{}
+	}
+	if err == nil {
+		delete(b.errors, method)
+		return
+	}
+	b.errors[method] = err
+}
+
+// Delay delays all following calls of the given method by the given latency. The calls return early if their context
+// is cancelled.
+func (b *Behavior) Delay(method string, latency time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.latencies == nil {
+		b.latencies = map[string]time.Duration{}
+	}
+	b.latencies[method] = latency
+}
+
+// Reset removes all injected failures and latencies and resets the number of calls.
+func (b *Behavior) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.errors, b.latencies, b.calls = nil, nil, nil
+}
+
+// Calls returns the number of calls of the given method.
+func (b *Behavior) Calls(method string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.calls[method]
+}
+
+// call records a call of the given method and applies the injected latency and failure.
+func (b *Behavior) call(ctx context.Context, method string) error {
+	b.mu.Lock()
+	if b.calls == nil {
+		b.calls = map[string]int{}
+	}
+	b.calls[method]++
+
+	latency, ok := b.latencies[method]
+	if !ok {
+		latency = b.latencies[AnyMethod]
+	}
+	err, ok := b.errors[method]
+	if !ok {
+		err = b.errors[AnyMethod]
+	}
+	b.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return err
+}
+
+// NewAPIError returns an error of the GCP APIs with the given HTTP status code and reason, e.g. to inject a
+// "rateLimitExceeded" error with status code 403 via Behavior.FailWith.
+func NewAPIError(code int, reason, message string) error {
+	err := &googleapi.Error{Code: code, Message: message}
+	if reason != "" {
+		err.Errors = []googleapi.ErrorItem{{Reason: reason, Message: message}}
+	}
+	return err
+}
+
+func notFoundError(kind, name string) error {
+	return NewAPIError(http.StatusNotFound, "notFound", fmt.Sprintf("The resource '%s/%s' was not found", kind, name))
+}
+
+func alreadyExistsError(kind, name string) error {
+	return NewAPIError(http.StatusConflict, "alreadyExists", fmt.Sprintf("The resource '%s/%s' already exists", kind, name))
+}
+
+// clone returns a deep copy of the given GCP API object.
+func clone[T any](in *T) *T {
+	if in == nil {
+		return nil
+	}
+	out := new(T)
+	mustRoundTrip(in, out)
+	return out
+}
+
+// patch returns a copy of the given GCP API object whose top-level fields are overwritten by the ones set in the given
+// patch, like the patch calls of the GCP APIs.
+func patch[T any](in, p *T) *T {
+	fields := map[string]json.RawMessage{}
+	mustRoundTrip(in, &fields)
+	patchFields := map[string]json.RawMessage{}
+	mustRoundTrip(p, &patchFields)
+	for k, v := range patchFields {
+		fields[k] = v
+	}
+
+	out := new(T)
+	mustRoundTrip(fields, out)
+	return out
+}
+
+func mustRoundTrip(in, out any) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		panic(err)
+	}
+}
+
+var _ gcpclient.Factory = &Factory{}
+
+// Factory is a gcpclient.Factory which returns the same fake clients for all secrets.
+type Factory struct {
+	// ComputeClient is returned by Compute.
+	ComputeClient *ComputeClient
+	// StorageClient is returned by Storage.
+	StorageClient *StorageClient
+	// DNSClient is returned by DNS.
+	DNSClient *DNSClient
+}
+
+// NewFactory returns a new Factory with empty fake clients for the project with the given ID.
+func NewFactory(projectID string) *Factory {
+	return &Factory{
+		ComputeClient: NewComputeClient(projectID),
+		StorageClient: NewStorageClient(),
+		DNSClient:     NewDNSClient(projectID),
+	}
+}
+
+// Compute returns the fake compute client.
+func (f *Factory) Compute(_ context.Context, _ client.Client, _ corev1.SecretReference) (gcpclient.ComputeClient, error) {
+	return f.ComputeClient, nil
+}
+
+// Storage returns the fake storage client.
+func (f *Factory) Storage(_ context.Context, _ client.Client, _ corev1.SecretReference) (gcpclient.StorageClient, error) {
+	return f.StorageClient, nil
+}
+
+// DNS returns the fake DNS client.
+func (f *Factory) DNS(_ context.Context, _ client.Client, _ corev1.SecretReference) (gcpclient.DNSClient, error) {
+	return f.DNSClient, nil
+}
+
+// IAM is not supported by the fake factory and always returns an error.
+func (f *Factory) IAM(_ context.Context, _ client.Client, _ corev1.SecretReference) (gcpclient.IAMClient, error) {
+	return nil, fmt.Errorf("the fake factory does not support IAM clients")
+}
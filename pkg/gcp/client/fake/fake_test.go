@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/storage"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/compute/v1"
+
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+var _ = Describe("Fake", func() {
+	var ctx = context.TODO()
+
+	Describe("ComputeClient", func() {
+		var c *ComputeClient
+
+		BeforeEach(func() {
+			c = NewComputeClient("foo")
+		})
+
+		It("should create, patch and delete networks", func() {
+			nw, err := c.InsertNetwork(ctx, &compute.Network{Name: "nw", AutoCreateSubnetworks: false})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nw.SelfLink).To(Equal("https://www.googleapis.com/compute/v1/projects/foo/global/networks/nw"))
+
+			_, err = c.InsertNetwork(ctx, &compute.Network{Name: "nw"})
+			Expect(gcpclient.IsErrorCode(err, http.StatusConflict)).To(BeTrue())
+
+			nw, err = c.PatchNetwork(ctx, "nw", &compute.Network{RoutingConfig: &compute.NetworkRoutingConfig{RoutingMode: "GLOBAL"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nw.Name).To(Equal("nw"))
+			Expect(nw.RoutingConfig.RoutingMode).To(Equal("GLOBAL"))
+
+			Expect(c.DeleteNetwork(ctx, "nw")).To(Succeed())
+			Expect(c.GetNetwork(ctx, "nw")).To(BeNil())
+			Expect(c.DeleteNetwork(ctx, "nw")).To(Succeed())
+		})
+
+		It("should not return references to the stored objects", func() {
+			_, err := c.InsertFirewallRule(ctx, &compute.Firewall{Name: "fw", Network: "nw"})
+			Expect(err).NotTo(HaveOccurred())
+
+			fw, err := c.GetFirewallRule(ctx, "fw")
+			Expect(err).NotTo(HaveOccurred())
+			fw.Network = "other"
+
+			Expect(c.GetFirewallRule(ctx, "fw")).To(HaveField("Network", "nw"))
+		})
+
+		It("should apply the client side filter when listing", func() {
+			c.AddRoute(&compute.Route{Name: "a", Network: "nw"})
+			c.AddRoute(&compute.Route{Name: "b", Network: "other"})
+
+			routes, err := c.ListRoutes(ctx, gcpclient.RouteListOpts{ClientFilter: func(r *compute.Route) bool { return r.Network == "nw" }})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(routes).To(ConsistOf(HaveField("Name", "a")))
+		})
+
+		It("should inject failures and latencies", func() {
+			c.FailWith("GetRouter", NewAPIError(http.StatusNotFound, "notFound", "not found"))
+			c.Delay(AnyMethod, 50*time.Millisecond)
+
+			_, err := c.GetRouter(ctx, "europe-west1", "router")
+			Expect(gcpclient.IsNotFoundError(err)).To(BeTrue())
+			Expect(c.Calls("GetRouter")).To(Equal(1))
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			_, err = c.GetNetwork(timeoutCtx, "nw")
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+
+			c.Reset()
+			Expect(c.GetRouter(ctx, "europe-west1", "router")).To(BeNil())
+		})
+	})
+
+	Describe("StorageClient", func() {
+		var s *StorageClient
+
+		BeforeEach(func() {
+			s = NewStorageClient()
+			Expect(s.CreateBucket(ctx, &storage.BucketAttrs{Name: "bucket", RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: 24 * time.Hour}})).To(Succeed())
+		})
+
+		It("should not allow to reduce a locked retention policy", func() {
+			Expect(s.LockBucket(ctx, "bucket")).To(Succeed())
+
+			_, err := s.UpdateBucket(ctx, "bucket", storage.BucketAttrsToUpdate{RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: time.Hour}})
+			Expect(gcpclient.IsErrorCode(err, http.StatusForbidden)).To(BeTrue())
+
+			attrs, err := s.UpdateBucket(ctx, "bucket", storage.BucketAttrsToUpdate{RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: 48 * time.Hour}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attrs.RetentionPolicy.IsLocked).To(BeTrue())
+			Expect(attrs.RetentionPolicy.RetentionPeriod).To(Equal(48 * time.Hour))
+		})
+
+		It("should only mark objects under retention for deletion", func() {
+			Expect(s.AddObject("bucket", &storage.ObjectAttrs{Name: "shoot/old", Created: time.Now().Add(-48 * time.Hour)})).To(Succeed())
+			Expect(s.AddObject("bucket", &storage.ObjectAttrs{Name: "shoot/new"})).To(Succeed())
+			Expect(s.AddObject("bucket", &storage.ObjectAttrs{Name: "other/new"})).To(Succeed())
+
+			Expect(s.DeleteObjectsWithPrefix(ctx, "bucket", "shoot/")).To(Succeed())
+
+			Expect(s.Object("bucket", "shoot/old")).To(BeNil())
+			Expect(s.Object("bucket", "shoot/new").CustomTime).NotTo(BeZero())
+			Expect(s.Object("bucket", "other/new").CustomTime).To(BeZero())
+			Expect(gcpclient.IsErrorCode(s.DeleteBucketIfExists(ctx, "bucket"), http.StatusConflict)).To(BeTrue())
+		})
+	})
+
+	Describe("DNSClient", func() {
+		var d *DNSClient
+
+		BeforeEach(func() {
+			d = NewDNSClient("foo")
+		})
+
+		It("should manage the recordsets of managed zones", func() {
+			zoneID, err := d.CreateManagedZone(ctx, "", gcpclient.ManagedZoneSpec{DNSName: "example.com"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(zoneID).To(Equal("foo/example-com"))
+			Expect(d.GetManagedZones(ctx, "")).To(Equal(map[string]string{"example.com": "foo/example-com"}))
+
+			Expect(d.CreateOrUpdateRecordSet(ctx, zoneID, "www.example.com", "CNAME", []string{"example.com"}, 120)).To(Succeed())
+			Expect(d.RecordSet("example-com", "www.example.com.", "CNAME")).To(Equal(&RecordSet{Rrdatas: []string{"example.com."}, TTL: 120}))
+
+			Expect(d.DeleteRecordSet(ctx, zoneID, "www.example.com", "CNAME")).To(Succeed())
+			Expect(d.RecordSet(zoneID, "www.example.com", "CNAME")).To(BeNil())
+
+			Expect(gcpclient.IsNotFoundError(d.DeleteRecordSet(ctx, "foo/unknown", "www.example.com", "A"))).To(BeTrue())
+		})
+
+		It("should not reuse a managed zone with a different DNS name", func() {
+			Expect(d.CreateManagedZone(ctx, "", gcpclient.ManagedZoneSpec{DNSName: "example.com"})).To(Equal("foo/example-com"))
+			_, err := d.CreateManagedZone(ctx, "", gcpclient.ManagedZoneSpec{DNSName: "example-com"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should only delete empty managed zones", func() {
+			zoneID, err := d.CreateManagedZone(ctx, "", gcpclient.ManagedZoneSpec{DNSName: "example.com"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(d.CreateOrUpdateRecordSet(ctx, zoneID, "www.example.com", "A", []string{"1.2.3.4"}, 120)).To(Succeed())
+
+			Expect(d.DeleteManagedZone(ctx, zoneID)).To(Succeed())
+			Expect(d.GetManagedZones(ctx, "")).To(HaveKey("example.com"))
+
+			Expect(d.DeleteRecordSet(ctx, zoneID, "www.example.com", "A")).To(Succeed())
+			Expect(d.DeleteManagedZone(ctx, zoneID)).To(Succeed())
+			Expect(d.GetManagedZones(ctx, "")).To(BeEmpty())
+		})
+
+		It("should delete a recordset together with the last item of its routing policy", func() {
+			eu := gcpclient.RoutingPolicyItem{Location: "europe-west1"}
+			us := gcpclient.RoutingPolicyItem{Location: "us-east1"}
+			Expect(d.CreateManagedZone(ctx, "", gcpclient.ManagedZoneSpec{DNSName: "example.com"})).To(Equal("foo/example-com"))
+			Expect(d.CreateOrUpdateRoutingPolicyItem(ctx, "foo/example-com", "api.example.com", "A", eu, []string{"1.2.3.4"}, 120)).To(Succeed())
+			Expect(d.CreateOrUpdateRoutingPolicyItem(ctx, "foo/example-com", "api.example.com", "A", us, []string{"5.6.7.8"}, 120)).To(Succeed())
+
+			Expect(d.DeleteRoutingPolicyItem(ctx, "foo/example-com", "api.example.com", "A", eu)).To(Succeed())
+			Expect(d.RecordSet("foo/example-com", "api.example.com", "A").RoutingPolicy).To(Equal(map[gcpclient.RoutingPolicyItem][]string{us: {"5.6.7.8"}}))
+
+			Expect(d.DeleteRoutingPolicyItem(ctx, "foo/example-com", "api.example.com", "A", us)).To(Succeed())
+			Expect(d.RecordSet("foo/example-com", "api.example.com", "A")).To(BeNil())
+		})
+	})
+})
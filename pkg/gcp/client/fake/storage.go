@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+var _ gcpclient.StorageClient = &StorageClient{}
+
+// StorageClient is an in-memory fake of gcpclient.StorageClient. It enforces the retention policies of the buckets,
+// i.e. locked retention policies cannot be reduced or removed, and objects under retention are not deleted.
+type StorageClient struct {
+	Behavior
+
+	mu      sync.Mutex
+	buckets map[string]*storage.BucketAttrs
+	objects map[string]map[string]*storage.ObjectAttrs
+}
+
+// NewStorageClient returns a new empty StorageClient.
+func NewStorageClient() *StorageClient {
+	return &StorageClient{
+		buckets: map[string]*storage.BucketAttrs{},
+		objects: map[string]map[string]*storage.ObjectAttrs{},
+	}
+}
+
+// AddObject adds an object with the given attributes to the given bucket. The creation time of the object is set to
+// the current time if it is not set.
+func (s *StorageClient) AddObject(bucketName string, attrs *storage.ObjectAttrs) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.buckets[bucketName]; !ok {
+		return storage.ErrBucketNotExist
+	}
+	attrs = copyObjectAttrs(attrs)
+	attrs.Bucket = bucketName
+	if attrs.Created.IsZero() {
+		attrs.Created = time.Now()
+	}
+	s.objects[bucketName][attrs.Name] = attrs
+	return nil
+}
+
+// Object returns the attributes of the given object, or nil if the object does not exist.
+func (s *StorageClient) Object(bucketName, objectName string) *storage.ObjectAttrs {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if attrs, ok := s.objects[bucketName][objectName]; ok {
+		return copyObjectAttrs(attrs)
+	}
+	return nil
+}
+
+// Attrs returns the attributes of the given bucket.
+func (s *StorageClient) Attrs(ctx context.Context, bucketName string) (*storage.BucketAttrs, error) {
+	if err := s.call(ctx, "Attrs"); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attrs, ok := s.buckets[bucketName]
+	if !ok {
+		return nil, storage.ErrBucketNotExist
+	}
+	return copyBucketAttrs(attrs), nil
+}
+
+// CreateBucket creates a new bucket with the given attributes.
+func (s *StorageClient) CreateBucket(ctx context.Context, attrs *storage.BucketAttrs) error {
+	if err := s.call(ctx, "CreateBucket"); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.buckets[attrs.Name]; ok {
+		return NewAPIError(http.StatusConflict, "conflict", fmt.Sprintf("The bucket %s already exists", attrs.Name))
+	}
+	attrs = copyBucketAttrs(attrs)
+	attrs.Created = time.Now()
+	attrs.MetaGeneration = 1
+	if attrs.RetentionPolicy != nil {
+		attrs.RetentionPolicy.EffectiveTime = attrs.Created
+		attrs.RetentionPolicy.IsLocked = false
+	}
+	s.buckets[attrs.Name] = attrs
+	s.objects[attrs.Name] = map[string]*storage.ObjectAttrs{}
+	return nil
+}
+
+// UpdateBucket updates the versioning, the retention policy and the lifecycle of the given bucket.
+func (s *StorageClient) UpdateBucket(ctx context.Context, bucketName string, attrsToUpdate storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+	if err := s.call(ctx, "UpdateBucket"); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attrs, ok := s.buckets[bucketName]
+	if !ok {
+		return nil, storage.ErrBucketNotExist
+	}
+
+	if policy := attrsToUpdate.RetentionPolicy; policy != nil {
+		if attrs.RetentionPolicy != nil && attrs.RetentionPolicy.IsLocked && policy.RetentionPeriod < attrs.RetentionPolicy.RetentionPeriod {
+			return nil, NewAPIError(http.StatusForbidden, "forbidden", fmt.Sprintf("The retention policy of the locked bucket %s cannot be reduced", bucketName))
+		}
+		switch {
+		case policy.RetentionPeriod == 0:
+			attrs.RetentionPolicy = nil
+		case attrs.RetentionPolicy == nil:
+			attrs.RetentionPolicy = &storage.RetentionPolicy{RetentionPeriod: policy.RetentionPeriod, EffectiveTime: time.Now()}
+		default:
+			attrs.RetentionPolicy.RetentionPeriod = policy.RetentionPeriod
+		}
+	}
+	if attrsToUpdate.Lifecycle != nil {
+		attrs.Lifecycle = *attrsToUpdate.Lifecycle
+	}
+	if versioningEnabled, ok := attrsToUpdate.VersioningEnabled.(bool); ok {
+		attrs.VersioningEnabled = versioningEnabled
+	}
+	attrs.MetaGeneration++
+	return copyBucketAttrs(attrs), nil
+}
+
+// LockBucket locks the retention policy of the given bucket.
+func (s *StorageClient) LockBucket(ctx context.Context, bucketName string) error {
+	if err := s.call(ctx, "LockBucket"); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attrs, ok := s.buckets[bucketName]
+	if !ok {
+		return fmt.Errorf("failed to get attributes for bucket %q while attempting to lock retention policy: %w", bucketName, storage.ErrBucketNotExist)
+	}
+	if attrs.RetentionPolicy == nil {
+		return fmt.Errorf("failed to lock retention policy for bucket %q: %w", bucketName,
+			NewAPIError(http.StatusBadRequest, "invalid", "The bucket does not have a retention policy"))
+	}
+	attrs.RetentionPolicy.IsLocked = true
+	attrs.MetaGeneration++
+	return nil
+}
+
+// DeleteBucketIfExists deletes the given bucket. Returns no error if the bucket does not exist, but fails if it still
+// contains objects.
+func (s *StorageClient) DeleteBucketIfExists(ctx context.Context, bucketName string) error {
+	if err := s.call(ctx, "DeleteBucketIfExists"); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.objects[bucketName]) > 0 {
+		return NewAPIError(http.StatusConflict, "conflict", fmt.Sprintf("The bucket %s you tried to delete is not empty", bucketName))
+	}
+	delete(s.buckets, bucketName)
+	delete(s.objects, bucketName)
+	return nil
+}
+
+// DeleteObjectsWithPrefix deletes the objects of the given bucket with the given prefix. Like the real client, it sets
+// the custom time of objects which are still under retention instead of deleting them.
+func (s *StorageClient) DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string) error {
+	if err := s.call(ctx, "DeleteObjectsWithPrefix"); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attrs, ok := s.buckets[bucketName]
+	if !ok {
+		return fmt.Errorf("failed to list objects in bucket %s with prefix %s: %w", bucketName, prefix, storage.ErrBucketNotExist)
+	}
+
+	now := time.Now()
+	for name, object := range s.objects[bucketName] {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if attrs.RetentionPolicy != nil && now.Before(object.Created.Add(attrs.RetentionPolicy.RetentionPeriod)) {
+			if object.CustomTime.IsZero() {
+				object.CustomTime = now.UTC()
+			}
+			continue
+		}
+		delete(s.objects[bucketName], name)
+	}
+	return nil
+}
+
+func copyBucketAttrs(in *storage.BucketAttrs) *storage.BucketAttrs {
+	out := *in
+	if in.RetentionPolicy != nil {
+		policy := *in.RetentionPolicy
+		out.RetentionPolicy = &policy
+	}
+	out.Labels = maps.Clone(in.Labels)
+	out.Lifecycle.Rules = slices.Clone(in.Lifecycle.Rules)
+	return &out
+}
+
+func copyObjectAttrs(in *storage.ObjectAttrs) *storage.ObjectAttrs {
+	out := *in
+	return &out
+}
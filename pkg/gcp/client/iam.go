@@ -10,7 +10,6 @@ import (
 	"regexp"
 
 	"google.golang.org/api/iam/v1"
-	"google.golang.org/api/option"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
@@ -38,8 +37,9 @@ func NewIAMClient(ctx context.Context, credentialsConfig *gcp.CredentialsConfig)
 	if err != nil {
 		return nil, err
 	}
+	httpClient.Transport = newMetricsTransport(httpClient.Transport, serviceIAM)
 
-	service, err := iam.NewService(ctx, option.WithHTTPClient(httpClient))
+	service, err := iam.NewService(ctx, clientOptions(httpClient, DefaultAPIEndpoints.IAM)...)
 	if err != nil {
 		return nil, err
 	}
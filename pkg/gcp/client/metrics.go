@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	serviceCompute = "compute"
+	serviceDNS     = "dns"
+	serviceIAM     = "iam"
+	serviceStorage = "storage"
+)
+
+var (
+	// apiRequestsTotal is the number of requests to the GCP APIs, partitioned by service, API method and response code.
+	apiRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gcp_api_requests_total",
+		Help: "Total number of requests to the GCP APIs, partitioned by service, API method and response code.",
+	}, []string{"service", "method", "code"})
+
+	// apiRequestDuration is the latency of the requests to the GCP APIs, partitioned by service, API method and response
+	// code.
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "gcp_api_request_duration_seconds",
+		Help:    "Latency of the requests to the GCP APIs in seconds, partitioned by service, API method and response code.",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"service", "method", "code"})
+)
+
+func init() {
+	// The metrics are served by the metrics endpoint of the controller manager.
+	metrics.Registry.MustRegister(apiRequestsTotal, apiRequestDuration)
+}
+
+// metricsTransport is a http.RoundTripper which records the number and the latency of the requests to a GCP API.
+type metricsTransport struct {
+	base    http.RoundTripper
+	service string
+}
+
+func newMetricsTransport(base http.RoundTripper, service string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &metricsTransport{base: base, service: service}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if counter, ok := req.Context().Value(requestCounterKey{}).(*atomic.Int64); ok {
+		counter.Add(1)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+
+	// Requests which did not receive a response, e.g. because of a timeout, are recorded with the code "error".
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+
+	method := apiMethod(req)
+	apiRequestsTotal.WithLabelValues(t.service, method, code).Inc()
+	apiRequestDuration.WithLabelValues(t.service, method, code).Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
+
+// apiMethod returns the API method of the given request, which consists of the HTTP method and the template of the
+// path, e.g. `GET /compute/v1/projects/{}/regions/{}/routers/{}`. The paths of the GCP APIs alternate between the names
+// of collections and the identifiers of resources after the version of the API, except for the `global` and
+// `aggregated` scopes of the Compute Engine API. The identifiers are replaced with `{}` to keep the number of label
+// values bounded. Custom methods, e.g. `setMetadata` or `:signBlob`, are kept as they are part of the API.
+func apiMethod(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.EscapedPath(), "/"), "/")
+
+	version := slices.IndexFunc(segments, isAPIVersion)
+	if version < 0 {
+		return req.Method + " unknown"
+	}
+
+	identifier := false
+	for i := version + 1; i < len(segments); i++ {
+		switch {
+		case !identifier && (segments[i] == "global" || segments[i] == "aggregated"):
+		case identifier:
+			template := "{}"
+			if j := strings.LastIndex(segments[i], ":"); j >= 0 {
+				template += segments[i][j:]
+			}
+			segments[i] = template
+			identifier = false
+		default:
+			identifier = true
+		}
+	}
+	return req.Method + " /" + strings.Join(segments, "/")
+}
+
+// isAPIVersion returns whether the given path segment is the version of a GCP API, e.g. `v1` or `v1beta1`.
+func isAPIVersion(segment string) bool {
+	return len(segment) > 1 && segment[0] == 'v' && segment[1] >= '0' && segment[1] <= '9'
+}
+
+type requestCounterKey struct{}
+
+// WithRequestCounter returns a context which counts the requests to the GCP APIs that are sent with it, and a function
+// returning the number of requests counted so far.
+func WithRequestCounter(ctx context.Context) (context.Context, func() int64) {
+	counter := &atomic.Int64{}
+	return context.WithValue(ctx, requestCounterKey{}, counter), counter.Load
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("API metrics", func() {
+	var (
+		server *httptest.Server
+		client *http.Client
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodDelete {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		client = &http.Client{Transport: newMetricsTransport(nil, "test")}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should record the requests by service, API method and response code", func() {
+		for _, router := range []string{"router-1", "router-2"} {
+			resp, err := client.Get(server.URL + "/compute/v1/projects/foo/regions/europe-west1/routers/" + router)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+		}
+		req, err := http.NewRequest(http.MethodDelete, server.URL+"/compute/v1/projects/foo/global/networks/bar", nil)
+		Expect(err).NotTo(HaveOccurred())
+		resp, err := client.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+
+		Expect(testutil.ToFloat64(apiRequestsTotal.WithLabelValues("test", "GET /compute/v1/projects/{}/regions/{}/routers/{}", "200"))).To(Equal(float64(2)))
+		Expect(testutil.ToFloat64(apiRequestsTotal.WithLabelValues("test", "DELETE /compute/v1/projects/{}/global/networks/{}", "404"))).To(Equal(float64(1)))
+		Expect(testutil.CollectAndCount(apiRequestDuration)).To(BeNumerically(">=", 2))
+	})
+
+	It("should record requests without a response with the code error", func() {
+		server.Close()
+
+		_, err := client.Get(server.URL + "/dns/v1/projects/foo/managedZones/bar")
+		Expect(err).To(HaveOccurred())
+
+		Expect(testutil.ToFloat64(apiRequestsTotal.WithLabelValues("test", "GET /dns/v1/projects/{}/managedZones/{}", "error"))).To(Equal(float64(1)))
+	})
+
+	DescribeTable("#apiMethod",
+		func(method, path, expected string) {
+			req, err := http.NewRequest(method, "https://example.com"+path, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(apiMethod(req)).To(Equal(expected))
+		},
+		Entry("list", http.MethodGet, "/compute/v1/projects/foo/zones/europe-west1-b/instances", "GET /compute/v1/projects/{}/zones/{}/instances"),
+		Entry("aggregated list", http.MethodGet, "/compute/v1/projects/foo/aggregated/instances", "GET /compute/v1/projects/{}/aggregated/instances"),
+		Entry("custom method", http.MethodPost, "/compute/v1/projects/foo/regions/europe-west1/operations/operation-123/wait", "POST /compute/v1/projects/{}/regions/{}/operations/{}/wait"),
+		Entry("custom method with colon", http.MethodPost, "/v1/projects/foo/serviceAccounts/bar@foo.iam.gserviceaccount.com:signBlob", "POST /v1/projects/{}/serviceAccounts/{}:signBlob"),
+		Entry("escaped object name", http.MethodGet, "/storage/v1/b/bucket/o/shoot--foo--bar%2Fetcd%2Fbackup", "GET /storage/v1/b/{}/o/{}"),
+		Entry("upload", http.MethodPost, "/upload/storage/v1/b/bucket/o", "POST /upload/storage/v1/b/{}/o"),
+		Entry("without version", http.MethodGet, "/foo/bar", "GET unknown"),
+	)
+})
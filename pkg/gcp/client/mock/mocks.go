@@ -129,6 +129,21 @@ func (m *MockDNSClient) EXPECT() *MockDNSClientMockRecorder {
 	return m.recorder
 }
 
+// CreateManagedZone mocks base method.
+func (m *MockDNSClient) CreateManagedZone(ctx context.Context, projectID string, spec client.ManagedZoneSpec) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateManagedZone", ctx, projectID, spec)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManagedZone indicates an expected call of CreateManagedZone.
+func (mr *MockDNSClientMockRecorder) CreateManagedZone(ctx, projectID, spec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManagedZone", reflect.TypeOf((*MockDNSClient)(nil).CreateManagedZone), ctx, projectID, spec)
+}
+
 // CreateOrUpdateRecordSet mocks base method.
 func (m *MockDNSClient) CreateOrUpdateRecordSet(ctx context.Context, managedZone, name, recordType string, rrdatas []string, ttl int64) error {
 	m.ctrl.T.Helper()
@@ -143,6 +158,34 @@ func (mr *MockDNSClientMockRecorder) CreateOrUpdateRecordSet(ctx, managedZone, n
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateRecordSet", reflect.TypeOf((*MockDNSClient)(nil).CreateOrUpdateRecordSet), ctx, managedZone, name, recordType, rrdatas, ttl)
 }
 
+// CreateOrUpdateRoutingPolicyItem mocks base method.
+func (m *MockDNSClient) CreateOrUpdateRoutingPolicyItem(ctx context.Context, managedZone, name, recordType string, item client.RoutingPolicyItem, rrdatas []string, ttl int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateRoutingPolicyItem", ctx, managedZone, name, recordType, item, rrdatas, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateRoutingPolicyItem indicates an expected call of CreateOrUpdateRoutingPolicyItem.
+func (mr *MockDNSClientMockRecorder) CreateOrUpdateRoutingPolicyItem(ctx, managedZone, name, recordType, item, rrdatas, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateRoutingPolicyItem", reflect.TypeOf((*MockDNSClient)(nil).CreateOrUpdateRoutingPolicyItem), ctx, managedZone, name, recordType, item, rrdatas, ttl)
+}
+
+// DeleteManagedZone mocks base method.
+func (m *MockDNSClient) DeleteManagedZone(ctx context.Context, managedZone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManagedZone", ctx, managedZone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteManagedZone indicates an expected call of DeleteManagedZone.
+func (mr *MockDNSClientMockRecorder) DeleteManagedZone(ctx, managedZone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManagedZone", reflect.TypeOf((*MockDNSClient)(nil).DeleteManagedZone), ctx, managedZone)
+}
+
 // DeleteRecordSet mocks base method.
 func (m *MockDNSClient) DeleteRecordSet(ctx context.Context, managedZone, name, recordType string) error {
 	m.ctrl.T.Helper()
@@ -157,19 +200,33 @@ func (mr *MockDNSClientMockRecorder) DeleteRecordSet(ctx, managedZone, name, rec
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecordSet", reflect.TypeOf((*MockDNSClient)(nil).DeleteRecordSet), ctx, managedZone, name, recordType)
 }
 
+// DeleteRoutingPolicyItem mocks base method.
+func (m *MockDNSClient) DeleteRoutingPolicyItem(ctx context.Context, managedZone, name, recordType string, item client.RoutingPolicyItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoutingPolicyItem", ctx, managedZone, name, recordType, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoutingPolicyItem indicates an expected call of DeleteRoutingPolicyItem.
+func (mr *MockDNSClientMockRecorder) DeleteRoutingPolicyItem(ctx, managedZone, name, recordType, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutingPolicyItem", reflect.TypeOf((*MockDNSClient)(nil).DeleteRoutingPolicyItem), ctx, managedZone, name, recordType, item)
+}
+
 // GetManagedZones mocks base method.
-func (m *MockDNSClient) GetManagedZones(ctx context.Context) (map[string]string, error) {
+func (m *MockDNSClient) GetManagedZones(ctx context.Context, projectID string) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetManagedZones", ctx)
+	ret := m.ctrl.Call(m, "GetManagedZones", ctx, projectID)
 	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetManagedZones indicates an expected call of GetManagedZones.
-func (mr *MockDNSClientMockRecorder) GetManagedZones(ctx any) *gomock.Call {
+func (mr *MockDNSClientMockRecorder) GetManagedZones(ctx, projectID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagedZones", reflect.TypeOf((*MockDNSClient)(nil).GetManagedZones), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagedZones", reflect.TypeOf((*MockDNSClient)(nil).GetManagedZones), ctx, projectID)
 }
 
 // MockComputeClient is a mock of ComputeClient interface.
@@ -280,6 +337,20 @@ func (mr *MockComputeClientMockRecorder) DeleteRouter(ctx, region, id any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRouter", reflect.TypeOf((*MockComputeClient)(nil).DeleteRouter), ctx, region, id)
 }
 
+// DeleteStoragePool mocks base method.
+func (m *MockComputeClient) DeleteStoragePool(ctx context.Context, zone, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStoragePool", ctx, zone, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStoragePool indicates an expected call of DeleteStoragePool.
+func (mr *MockComputeClientMockRecorder) DeleteStoragePool(ctx, zone, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoragePool", reflect.TypeOf((*MockComputeClient)(nil).DeleteStoragePool), ctx, zone, name)
+}
+
 // DeleteSubnet mocks base method.
 func (m *MockComputeClient) DeleteSubnet(ctx context.Context, region, id string) error {
 	m.ctrl.T.Helper()
@@ -369,6 +440,21 @@ func (mr *MockComputeClientMockRecorder) GetFirewallRule(ctx, firewall any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRule", reflect.TypeOf((*MockComputeClient)(nil).GetFirewallRule), ctx, firewall)
 }
 
+// GetForwardingRule mocks base method.
+func (m *MockComputeClient) GetForwardingRule(ctx context.Context, region, name string) (*compute.ForwardingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForwardingRule", ctx, region, name)
+	ret0, _ := ret[0].(*compute.ForwardingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForwardingRule indicates an expected call of GetForwardingRule.
+func (mr *MockComputeClientMockRecorder) GetForwardingRule(ctx, region, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForwardingRule", reflect.TypeOf((*MockComputeClient)(nil).GetForwardingRule), ctx, region, name)
+}
+
 // GetInstance mocks base method.
 func (m *MockComputeClient) GetInstance(ctx context.Context, zone, instanceName string) (*compute.Instance, error) {
 	m.ctrl.T.Helper()
@@ -384,6 +470,21 @@ func (mr *MockComputeClientMockRecorder) GetInstance(ctx, zone, instanceName any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstance", reflect.TypeOf((*MockComputeClient)(nil).GetInstance), ctx, zone, instanceName)
 }
 
+// GetMachineType mocks base method.
+func (m *MockComputeClient) GetMachineType(ctx context.Context, zone, name string) (*compute.MachineType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineType", ctx, zone, name)
+	ret0, _ := ret[0].(*compute.MachineType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineType indicates an expected call of GetMachineType.
+func (mr *MockComputeClientMockRecorder) GetMachineType(ctx, zone, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineType", reflect.TypeOf((*MockComputeClient)(nil).GetMachineType), ctx, zone, name)
+}
+
 // GetNetwork mocks base method.
 func (m *MockComputeClient) GetNetwork(ctx context.Context, id string) (*compute.Network, error) {
 	m.ctrl.T.Helper()
@@ -429,6 +530,21 @@ func (mr *MockComputeClientMockRecorder) GetRouter(ctx, region, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouter", reflect.TypeOf((*MockComputeClient)(nil).GetRouter), ctx, region, id)
 }
 
+// GetStoragePool mocks base method.
+func (m *MockComputeClient) GetStoragePool(ctx context.Context, zone, name string) (*compute.StoragePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoragePool", ctx, zone, name)
+	ret0, _ := ret[0].(*compute.StoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoragePool indicates an expected call of GetStoragePool.
+func (mr *MockComputeClientMockRecorder) GetStoragePool(ctx, zone, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePool", reflect.TypeOf((*MockComputeClient)(nil).GetStoragePool), ctx, zone, name)
+}
+
 // GetSubnet mocks base method.
 func (m *MockComputeClient) GetSubnet(ctx context.Context, region, id string) (*compute.Subnetwork, error) {
 	m.ctrl.T.Helper()
@@ -519,6 +635,21 @@ func (mr *MockComputeClientMockRecorder) InsertRouter(ctx, region, router any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRouter", reflect.TypeOf((*MockComputeClient)(nil).InsertRouter), ctx, region, router)
 }
 
+// InsertStoragePool mocks base method.
+func (m *MockComputeClient) InsertStoragePool(ctx context.Context, zone string, pool *compute.StoragePool) (*compute.StoragePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertStoragePool", ctx, zone, pool)
+	ret0, _ := ret[0].(*compute.StoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertStoragePool indicates an expected call of InsertStoragePool.
+func (mr *MockComputeClientMockRecorder) InsertStoragePool(ctx, zone, pool any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertStoragePool", reflect.TypeOf((*MockComputeClient)(nil).InsertStoragePool), ctx, zone, pool)
+}
+
 // InsertSubnet mocks base method.
 func (m *MockComputeClient) InsertSubnet(ctx context.Context, region string, subnet *compute.Subnetwork) (*compute.Subnetwork, error) {
 	m.ctrl.T.Helper()
@@ -579,6 +710,36 @@ func (mr *MockComputeClientMockRecorder) ListRoutes(ctx, opts any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutes", reflect.TypeOf((*MockComputeClient)(nil).ListRoutes), ctx, opts)
 }
 
+// ListStoragePools mocks base method.
+func (m *MockComputeClient) ListStoragePools(ctx context.Context, opts client.StoragePoolListOpts) ([]*compute.StoragePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoragePools", ctx, opts)
+	ret0, _ := ret[0].([]*compute.StoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoragePools indicates an expected call of ListStoragePools.
+func (mr *MockComputeClientMockRecorder) ListStoragePools(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePools", reflect.TypeOf((*MockComputeClient)(nil).ListStoragePools), ctx, opts)
+}
+
+// ListZones mocks base method.
+func (m *MockComputeClient) ListZones(ctx context.Context, region string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListZones", ctx, region)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListZones indicates an expected call of ListZones.
+func (mr *MockComputeClientMockRecorder) ListZones(ctx, region any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZones", reflect.TypeOf((*MockComputeClient)(nil).ListZones), ctx, region)
+}
+
 // PatchFirewallRule mocks base method.
 func (m *MockComputeClient) PatchFirewallRule(ctx context.Context, name string, firewall *compute.Firewall) (*compute.Firewall, error) {
 	m.ctrl.T.Helper()
@@ -639,6 +800,21 @@ func (mr *MockComputeClientMockRecorder) PatchSubnet(ctx, region, id, subnet any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSubnet", reflect.TypeOf((*MockComputeClient)(nil).PatchSubnet), ctx, region, id, subnet)
 }
 
+// UpdateStoragePool mocks base method.
+func (m *MockComputeClient) UpdateStoragePool(ctx context.Context, zone, name string, pool *compute.StoragePool) (*compute.StoragePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStoragePool", ctx, zone, name, pool)
+	ret0, _ := ret[0].(*compute.StoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStoragePool indicates an expected call of UpdateStoragePool.
+func (mr *MockComputeClientMockRecorder) UpdateStoragePool(ctx, zone, name, pool any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStoragePool", reflect.TypeOf((*MockComputeClient)(nil).UpdateStoragePool), ctx, zone, name, pool)
+}
+
 // WaitForIPv6Cidr mocks base method.
 func (m *MockComputeClient) WaitForIPv6Cidr(ctx context.Context, region, subnetID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -12,7 +12,6 @@ import (
 	"cloud.google.com/go/storage"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/api/iterator"
-	"google.golang.org/api/option"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -41,8 +40,9 @@ func NewStorageClient(ctx context.Context, credentialsConfig *gcp.CredentialsCon
 	if err != nil {
 		return nil, err
 	}
+	httpClient.Transport = newMetricsTransport(httpClient.Transport, serviceStorage)
 
-	client, err := storage.NewClient(ctx, option.WithHTTPClient(httpClient))
+	client, err := storage.NewClient(ctx, clientOptions(httpClient, DefaultAPIEndpoints.Storage)...)
 	if err != nil {
 		return nil, err
 	}
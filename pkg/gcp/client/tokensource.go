@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google/externalaccount"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+// externalAccountTokenSources are the token sources of external account credentials, mapped to the credentials
+// configuration, the token retriever and the scopes they were created for. The clients are created for each
+// reconciliation, hence the token sources are shared by them, so that the subject token is only exchanged for a new
+// access token when the cached one expires. Like the clients, they are only cached for a limited time and number, so
+// that the token sources of deleted secrets or rotated credentials do not pile up.
+var externalAccountTokenSources = utilcache.NewLRUExpireCache(clientCacheSize)
+
+// externalAccountTokenSource returns the shared token source of the given external account credentials configuration
+// for the given scopes.
+func externalAccountTokenSource(credentialsConfig *gcp.CredentialsConfig, scopes []string) (oauth2.TokenSource, error) {
+	key := fmt.Sprintf("%x/%s/%s", sha256.Sum256(credentialsConfig.Raw), credentialsConfig.TokenRetriever, strings.Join(scopes, " "))
+
+	if ts, ok := externalAccountTokenSources.Get(key); ok {
+		return ts.(oauth2.TokenSource), nil
+	}
+
+	// The token source outlives the context of the reconciliation it is created in, hence it must not be bound to it.
+	ts, err := externalaccount.NewTokenSource(withBaseHTTPClient(context.Background()), externalaccount.Config{
+		Audience:                       credentialsConfig.Audience,
+		SubjectTokenType:               credentialsConfig.SubjectTokenType,
+		TokenURL:                       credentialsConfig.TokenURL,
+		Scopes:                         scopes,
+		SubjectTokenSupplier:           credentialsConfig.TokenRetriever,
+		UniverseDomain:                 credentialsConfig.UniverseDomain,
+		ServiceAccountImpersonationURL: credentialsConfig.ServiceAccountImpersonationURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	externalAccountTokenSources.Add(key, ts, clientCacheTTL)
+	return ts, nil
+}
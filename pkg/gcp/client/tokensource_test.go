@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/oauth2/google/externalaccount"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+type fakeTokenRetriever struct {
+	name string
+}
+
+func (f *fakeTokenRetriever) SubjectToken(_ context.Context, _ externalaccount.SupplierOptions) (string, error) {
+	return "subject-token", nil
+}
+
+func (f *fakeTokenRetriever) String() string {
+	return f.name
+}
+
+var _ = Describe("External account token sources", func() {
+	var (
+		server    *httptest.Server
+		exchanges atomic.Int32
+	)
+
+	BeforeEach(func() {
+		exchanges.Store(0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			exchanges.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"access-token","issued_token_type":"urn:ietf:params:oauth:token-type:access_token","token_type":"Bearer","expires_in":3600}`))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	credentialsConfig := func(secretName string) *gcp.CredentialsConfig {
+		// The raw configuration contains the URL of the test server, so that the token sources are not shared by the
+		// tests.
+		return &gcp.CredentialsConfig{
+			Raw:              []byte(`{"type":"external_account","audience":"` + server.URL + `"}`),
+			Type:             gcp.ExternalAccountCredentialType,
+			Audience:         "//iam.googleapis.com/projects/1/locations/global/workloadIdentityPools/pool/providers/provider",
+			SubjectTokenType: "urn:ietf:params:oauth:token-type:jwt",
+			TokenURL:         server.URL,
+			TokenRetriever:   &fakeTokenRetriever{name: secretName},
+		}
+	}
+
+	It("should exchange the subject token only once for clients with the same credentials", func() {
+		for range 3 {
+			ts, err := externalAccountTokenSource(credentialsConfig("shoot--foo--bar/cloudprovider"), []string{"scope"})
+			Expect(err).NotTo(HaveOccurred())
+			token, err := ts.Token()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token.AccessToken).To(Equal("access-token"))
+		}
+
+		Expect(exchanges.Load()).To(Equal(int32(1)))
+	})
+
+	It("should not share the token sources of different secrets or scopes", func() {
+		for _, args := range []struct {
+			secretName string
+			scope      string
+		}{
+			{"shoot--foo--bar/cloudprovider", "scope"},
+			{"shoot--foo--baz/cloudprovider", "scope"},
+			{"shoot--foo--bar/cloudprovider", "other-scope"},
+		} {
+			ts, err := externalAccountTokenSource(credentialsConfig(args.secretName), []string{args.scope})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = ts.Token()
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		Expect(exchanges.Load()).To(Equal(int32(3)))
+	})
+})
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2"
+)
+
+// baseHTTPClient is the HTTP client which is used for all requests to the GCP APIs, including the ones to obtain access
+// tokens. Its transport honors the HTTPS_PROXY and NO_PROXY environment variables.
+var baseHTTPClient = &http.Client{Transport: http.DefaultTransport}
+
+// SetCABundle configures the clients to trust the certificate authorities of the given PEM encoded bundle in addition
+// to the system ones, e.g. the one of a TLS-inspecting proxy. It must be called before any client is created.
+func SetCABundle(caBundle []byte) error {
+	if len(caBundle) == 0 {
+		return nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		return fmt.Errorf("failed to load system certificate pool: %w", err)
+	}
+	if !pool.AppendCertsFromPEM(caBundle) {
+		return fmt.Errorf("CA bundle does not contain any PEM encoded certificate")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    pool,
+		MinVersion: tls.VersionTLS12,
+	}
+	baseHTTPClient = &http.Client{Transport: transport}
+	return nil
+}
+
+// withBaseHTTPClient returns a context which makes the oauth2 package use the base HTTP client, both for obtaining
+// access tokens and as transport of the clients it creates.
+func withBaseHTTPClient(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, baseHTTPClient)
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/oauth2"
+)
+
+var _ = Describe("Transport", func() {
+	var (
+		server         *httptest.Server
+		originalClient *http.Client
+	)
+
+	BeforeEach(func() {
+		originalClient = baseHTTPClient
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+	})
+
+	AfterEach(func() {
+		baseHTTPClient = originalClient
+		server.Close()
+	})
+
+	Describe("#SetCABundle", func() {
+		It("should keep the default transport if no CA bundle is given", func() {
+			Expect(SetCABundle(nil)).To(Succeed())
+			Expect(baseHTTPClient).To(BeIdenticalTo(originalClient))
+		})
+
+		It("should fail if the CA bundle does not contain any certificate", func() {
+			Expect(SetCABundle([]byte("foo"))).To(MatchError(ContainSubstring("does not contain any PEM encoded certificate")))
+			Expect(baseHTTPClient).To(BeIdenticalTo(originalClient))
+		})
+
+		It("should trust the certificate authorities of the CA bundle", func() {
+			caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+			Expect(SetCABundle(caBundle)).To(Succeed())
+
+			client := oauth2.NewClient(withBaseHTTPClient(context.Background()), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}))
+			resp, err := client.Get(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
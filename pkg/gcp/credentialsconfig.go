@@ -23,6 +23,8 @@ type credConfig struct {
 	Email     string `json:"client_email"`
 	Type      string `json:"type"`
 
+	QuotaProjectID string `json:"quota_project_id"`
+
 	Audience                       string           `json:"audience"`
 	CredentialSource               credentialSource `json:"credential_source"`
 	UniverseDomain                 string           `json:"universe_domain"`
@@ -50,6 +52,10 @@ type CredentialsConfig struct {
 	Email string
 	// Type is the type of credentials.
 	Type string
+	// QuotaProject is the ID of the project which is used for the quota and billing of the API requests. If it is
+	// empty, the project of the credentials is used. It is read from the `quota_project_id` field of the credentials
+	// configuration, which is also honored by the other components using the credentials.
+	QuotaProject string
 
 	// The following fields are only used when the credentials configuration is of type "external_account".
 
@@ -81,7 +87,7 @@ func GetCredentialsConfigFromSecretReference(ctx context.Context, c client.Clien
 		return nil, err
 	}
 
-	credentialsConfig, err := getCredentialsConfigFromSecret(secret)
+	credentialsConfig, err := GetCredentialsConfigFromSecret(secret)
 	if err != nil {
 		return nil, err
 	}
@@ -97,8 +103,8 @@ func GetCredentialsConfigFromSecretReference(ctx context.Context, c client.Clien
 	return credentialsConfig, nil
 }
 
-// getCredentialsConfigFromSecret retrieves the credentials config from the secret.
-func getCredentialsConfigFromSecret(secret *corev1.Secret) (*CredentialsConfig, error) {
+// GetCredentialsConfigFromSecret retrieves the credentials config from the secret.
+func GetCredentialsConfigFromSecret(secret *corev1.Secret) (*CredentialsConfig, error) {
 	if data, ok := secret.Data[ServiceAccountJSONField]; ok {
 		credentialsConfig, err := GetCredentialsConfigFromJSON(data)
 		if err != nil {
@@ -140,6 +146,7 @@ func GetCredentialsConfigFromJSON(data []byte) (*CredentialsConfig, error) {
 		ProjectID:                      credentialsConfig.ProjectID,
 		Email:                          credentialsConfig.Email,
 		Type:                           credentialsConfig.Type,
+		QuotaProject:                   credentialsConfig.QuotaProjectID,
 		TokenFilePath:                  credentialsConfig.CredentialSource.File,
 		Audience:                       credentialsConfig.Audience,
 		UniverseDomain:                 credentialsConfig.UniverseDomain,
@@ -157,6 +164,11 @@ type tokenRetriever struct {
 
 var _ externalaccount.SubjectTokenSupplier = &tokenRetriever{}
 
+// String returns the reference of the secret the token is retrieved from, which identifies the retriever.
+func (t *tokenRetriever) String() string {
+	return t.secretNamespace + "/" + t.secretName
+}
+
 func (t *tokenRetriever) SubjectToken(ctx context.Context, _ externalaccount.SupplierOptions) (string, error) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
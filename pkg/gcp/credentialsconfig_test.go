@@ -62,6 +62,13 @@ var _ = Describe("Service Account", func() {
 
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should extract the quota project", func() {
+			actual, err := GetCredentialsConfigFromJSON([]byte(`{"project_id": "project", "quota_project_id": "billing-project"}`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.QuotaProject).To(Equal("billing-project"))
+		})
 	})
 
 	Describe("#ReadCredentialsConfigSecret", func() {
@@ -70,7 +77,7 @@ var _ = Describe("Service Account", func() {
 				ServiceAccountJSONField: credentialsConfigData,
 			}}
 
-			actual, err := getCredentialsConfigFromSecret(secret)
+			actual, err := GetCredentialsConfigFromSecret(secret)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(actual.Raw).To(Equal(credentialsConfigData))
 		})
@@ -81,7 +88,7 @@ var _ = Describe("Service Account", func() {
 				"credentialsConfig": data,
 			}}
 
-			actual, err := getCredentialsConfigFromSecret(secret)
+			actual, err := GetCredentialsConfigFromSecret(secret)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(actual).To(Equal(&CredentialsConfig{
 				Raw:                            data,
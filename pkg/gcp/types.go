@@ -38,11 +38,16 @@ const (
 	CSILivenessProbeImageName = "csi-liveness-probe"
 	// MachineControllerManagerProviderGCPImageName is the name of the MachineController GCP image.
 	MachineControllerManagerProviderGCPImageName = "machine-controller-manager-provider-gcp"
+	// KMSPluginImageName is the name of the GCP KMS plugin image.
+	KMSPluginImageName = "gcp-kms-plugin"
 
 	// ServiceAccountJSONField is the field in a secret where the service account JSON is stored at.
 	ServiceAccountJSONField = "serviceaccount.json"
 	// CredentialsConfigField is the field in a secret where the credentials config JSON is stored at.
 	CredentialsConfigField = "credentialsConfig"
+	// ImpersonateServiceAccountField is the field in a secret where the email of a service account to impersonate would
+	// be stored at. Impersonation is not supported, hence secrets containing it are rejected.
+	ImpersonateServiceAccountField = "impersonateServiceAccount"
 
 	// ServiceAccountCredentialType is the type of the credentials contained in the serviceaccount.json file.
 	ServiceAccountCredentialType = "service_account"
@@ -53,6 +58,17 @@ const (
 	CloudControllerManagerName = "cloud-controller-manager"
 	// IngressGCEName is a constant for the name of the ingress-gce deployment in the seed.
 	IngressGCEName = "ingress-gce"
+	// KMSEncryptionName is a constant for the name of the chart rendering the encryption configuration of the
+	// kube-apiserver with the KMS provider.
+	KMSEncryptionName = "kms-encryption"
+	// KMSProviderName is a constant for the name of the KMS provider in the encryption configuration of the
+	// kube-apiserver.
+	KMSProviderName = "gcp-kms"
+	// KMSPluginSocketDir is a constant for the directory containing the unix socket of the GCP KMS plugin.
+	KMSPluginSocketDir = "/var/run/kmsplugin"
+	// KMSEncryptionSecretName is a constant for the name of the secret containing the encryption configuration of the
+	// kube-apiserver with the KMS provider.
+	KMSEncryptionSecretName = "kube-apiserver-etcd-encryption-configuration-gcp-kms"
 	// CSIControllerName is a constant for the name of the CSI controller deployment in the seed.
 	CSIControllerName = "csi-driver-controller"
 	// CSIControllerConfigName is a constant for the name of the CSI controller config in the seed.
@@ -90,6 +106,62 @@ const (
 	// AnnotationEnableVolumeAttributesClass is the annotation to use on shoots to enable VolumeAttributesClasses
 	AnnotationEnableVolumeAttributesClass = "gcp.provider.extensions.gardener.cloud/enable-volume-attributes-class"
 
+	// VolumeSnapshotTypeSnapshots is the snapshot type for standard disk snapshots.
+	VolumeSnapshotTypeSnapshots = "snapshots"
+	// VolumeSnapshotTypeImages is the snapshot type for disk images.
+	VolumeSnapshotTypeImages = "images"
+	// VolumeSnapshotDeletionPolicyDelete is the VolumeSnapshotClass deletion policy which deletes the snapshot in GCP.
+	VolumeSnapshotDeletionPolicyDelete = "Delete"
+	// VolumeSnapshotDeletionPolicyRetain is the VolumeSnapshotClass deletion policy which retains the snapshot in GCP.
+	VolumeSnapshotDeletionPolicyRetain = "Retain"
+
+	// ReplicationTypeNone is the replication type for zonal persistent disks.
+	ReplicationTypeNone = "none"
+	// ReplicationTypeRegionalPD is the replication type for regional persistent disks.
+	ReplicationTypeRegionalPD = "regional-pd"
+	// StoragePoolTypeHyperdiskBalanced is the type for Hyperdisk Balanced storage pools.
+	StoragePoolTypeHyperdiskBalanced = "hyperdisk-balanced"
+	// StoragePoolTypeHyperdiskThroughput is the type for Hyperdisk Throughput storage pools.
+	StoragePoolTypeHyperdiskThroughput = "hyperdisk-throughput"
+
+	// LoadBalancerDefaultsConfigMapName is the name of the ConfigMap in the kube-system namespace of the shoot
+	// containing the defaults for Services of type LoadBalancer.
+	LoadBalancerDefaultsConfigMapName = "gcp-load-balancer-defaults"
+	// AnnotationLoadBalancerType is the annotation on Services to select the type of the load balancer.
+	AnnotationLoadBalancerType = "networking.gke.io/load-balancer-type"
+	// AnnotationLegacyLoadBalancerType is the deprecated annotation on Services to select the type of the load balancer.
+	AnnotationLegacyLoadBalancerType = "cloud.google.com/load-balancer-type"
+	// AnnotationInternalLoadBalancerAllowGlobalAccess is the annotation on Services to enable global access for
+	// internal load balancers.
+	AnnotationInternalLoadBalancerAllowGlobalAccess = "networking.gke.io/internal-load-balancer-allow-global-access"
+	// LoadBalancerTypeExternal is the type for external load balancers.
+	LoadBalancerTypeExternal = "External"
+	// LoadBalancerTypeInternal is the type for internal load balancers.
+	LoadBalancerTypeInternal = "Internal"
+	// AnnotationNetworkTier is the annotation on Services to select the network tier of external load balancers.
+	AnnotationNetworkTier = "cloud.google.com/network-tier"
+	// NetworkTierPremium is the premium network tier.
+	NetworkTierPremium = "Premium"
+	// NetworkTierStandard is the standard network tier.
+	NetworkTierStandard = "Standard"
+
+	// NodeIPAMModeRoutes is the node IPAM mode in which the cloud-controller-manager allocates the pod ranges of the
+	// nodes and creates a VPC route per node.
+	NodeIPAMModeRoutes = "Routes"
+	// NodeIPAMModeAliasIP is the node IPAM mode in which the pod ranges of the nodes are allocated as alias IP ranges
+	// from the secondary range of the nodes subnet.
+	NodeIPAMModeAliasIP = "AliasIP"
+
+	// AdditionalNetworkRoutesMetadataKey is the key of the instance metadata containing the routes which are to be
+	// configured for the additional network interfaces of a VM. Each line has the format `<interface index> <cidr>`.
+	AdditionalNetworkRoutesMetadataKey = "gardener-additional-network-routes"
+	// LocalSSDMountPathMetadataKey is the key of the instance metadata containing the path at which the local NVMe SSDs
+	// of a VM are to be mounted.
+	LocalSSDMountPathMetadataKey = "gardener-local-ssd-mount-path"
+	// ConcealMetadataServerMetadataKey is the key of the instance metadata which enables the concealment of the metadata
+	// server from the pods running on a VM.
+	ConcealMetadataServerMetadataKey = "gardener-conceal-metadata-server"
+
 	// WorkloadIdentityMountPath is the path where the workload identity token and GCP config file are usually mounted.
 	WorkloadIdentityMountPath = "/var/run/secrets/gardener.cloud/workload-identity"
 
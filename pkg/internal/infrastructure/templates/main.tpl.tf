@@ -37,6 +37,13 @@ resource "google_compute_subnetwork" "subnetwork-nodes" {
   ip_cidr_range = "{{ .networks.workers }}"
   network       = {{ .vpc.name }}
   region        = "{{ .google.region }}"
+{{- if .networks.podSecondaryRange }}
+
+  secondary_ip_range {
+    range_name    = "ipv4-pod-cidr"
+    ip_cidr_range = "{{ .networks.podSecondaryRange }}"
+  }
+{{- end }}
 {{- if .networks.flowLogs }}
   log_config {
     {{ if .networks.flowLogs.aggregationInterval }}aggregation_interval = "{{ .networks.flowLogs.aggregationInterval }}"{{ end }}
@@ -56,6 +56,7 @@ func ComputeTerraformerTemplateValues(
 	config *api.InfrastructureConfig,
 	podCIDR *string,
 	createSA bool,
+	aliasIPEnabled bool,
 ) (map[string]interface{}, error) {
 	var (
 		vpcName           = DefaultVPCName
@@ -193,6 +194,11 @@ func ComputeTerraformerTemplateValues(
 		values["networks"].(map[string]interface{})["flowLogs"] = fl
 	}
 
+	// The pod network is added as secondary range if the pod ranges of the nodes are allocated as alias IP ranges.
+	if aliasIPEnabled && podCIDR != nil {
+		values["networks"].(map[string]interface{})["podSecondaryRange"] = *podCIDR
+	}
+
 	return values, nil
 }
 
@@ -203,8 +209,9 @@ func RenderTerraformerTemplate(
 	config *api.InfrastructureConfig,
 	podCIDR *string,
 	createSA bool,
+	aliasIPEnabled bool,
 ) (*TerraformFiles, error) {
-	values, err := ComputeTerraformerTemplateValues(infra, account, config, podCIDR, createSA)
+	values, err := ComputeTerraformerTemplateValues(infra, account, config, podCIDR, createSA, aliasIPEnabled)
 	if err != nil {
 		return nil, fmt.Errorf("failed to compute terraform values: %v", err)
 	}
@@ -211,7 +211,7 @@ var _ = Describe("Terraform", func() {
 
 	Describe("#ComputeTerraformerTemplateValues", func() {
 		It("should correctly compute the terraformer chart values without serviceAccount", func() {
-			values, err := ComputeTerraformerTemplateValues(infra, credentialsConfig, config, &podCIDR, false)
+			values, err := ComputeTerraformerTemplateValues(infra, credentialsConfig, config, &podCIDR, false, false)
 			Expect(err).To(BeNil())
 			Expect(values).To(Equal(map[string]interface{}{
 				"google": map[string]interface{}{
@@ -247,8 +247,14 @@ var _ = Describe("Terraform", func() {
 			}))
 		})
 
+		It("should add the pod network as secondary range of the nodes subnet if alias IPs are enabled", func() {
+			values, err := ComputeTerraformerTemplateValues(infra, credentialsConfig, config, &podCIDR, false, true)
+			Expect(err).To(BeNil())
+			Expect(values["networks"]).To(HaveKeyWithValue("podSecondaryRange", podCIDR))
+		})
+
 		It("should correctly compute the terraformer chart values with serviceAccount", func() {
-			values, err := ComputeTerraformerTemplateValues(infra, credentialsConfig, config, &podCIDR, true)
+			values, err := ComputeTerraformerTemplateValues(infra, credentialsConfig, config, &podCIDR, true, false)
 			Expect(err).To(BeNil())
 			Expect(values).To(Equal(map[string]interface{}{
 				"google": map[string]interface{}{
@@ -313,7 +319,7 @@ var _ = Describe("Terraform", func() {
 				},
 			}
 
-			values, err := ComputeTerraformerTemplateValues(infra, credentialsConfig, config, &podCIDR, true)
+			values, err := ComputeTerraformerTemplateValues(infra, credentialsConfig, config, &podCIDR, true, false)
 			Expect(err).To(BeNil())
 			Expect(values).To(Equal(map[string]interface{}{
 				"google": map[string]interface{}{
@@ -385,7 +391,7 @@ var _ = Describe("Terraform", func() {
 				},
 			}
 
-			values, err := ComputeTerraformerTemplateValues(infra, credentialsConfig, config, &podCIDR, true)
+			values, err := ComputeTerraformerTemplateValues(infra, credentialsConfig, config, &podCIDR, true, false)
 			Expect(err).To(BeNil())
 			Expect(values).To(Equal(map[string]interface{}{
 				"google": map[string]interface{}{
@@ -428,7 +434,7 @@ var _ = Describe("Terraform", func() {
 
 		It("should correctly compute the terraformer chart values with vpc creation", func() {
 			config.Networks.VPC = nil
-			values, err := ComputeTerraformerTemplateValues(infra, credentialsConfig, config, &podCIDR, true)
+			values, err := ComputeTerraformerTemplateValues(infra, credentialsConfig, config, &podCIDR, true, false)
 			Expect(err).To(BeNil())
 			Expect(values).To(Equal(map[string]interface{}{
 				"google": map[string]interface{}{
@@ -80,6 +80,10 @@ func (e *ensurer) EnsureCloudProviderSecret(_ context.Context, _ gcontext.Garden
 			"type": "text",
 		},
 	}
+	// The quota project is part of the credentials configuration, so that it is honored by all components using it.
+	if len(workloadIdentityConfig.QuotaProject) > 0 {
+		config["quota_project_id"] = workloadIdentityConfig.QuotaProject
+	}
 	newConfig, err := json.Marshal(config)
 	if err != nil {
 		return fmt.Errorf("could not marshal new config: %w", err)
@@ -90,6 +90,13 @@ credentialsConfig:
 			Expect(secret.Data["projectID"]).To(Equal([]byte("test-proj")))
 		})
 
+		It("should add the quota project from config to the credentials config", func() {
+			secret.Data["config"] = append(secret.Data["config"], []byte("quotaProject: billing-proj\n")...)
+			Expect(ensurer.EnsureCloudProviderSecret(ctx, nil, secret, nil)).To(Succeed())
+			Expect(secret.Data["credentialsConfig"]).To(ContainSubstring(`"quota_project_id":"billing-proj"`))
+			Expect(secret.Data).NotTo(HaveKey("quotaProject"))
+		})
+
 		It("should not modify the secret if it is not labeled correctly", func() {
 			secret.Labels["workloadidentity.security.gardener.cloud/provider"] = "foo"
 			expected := secret.DeepCopy()
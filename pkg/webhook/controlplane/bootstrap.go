@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	nodeBootstrapUnitName   = "gcp-node-bootstrap.service"
+	nodeBootstrapScriptPath = "/opt/bin/gcp-node-bootstrap.sh"
+)
+
+var (
+	nodeBootstrapScript = `#!/bin/bash
+set -o nounset
+set -o pipefail
+
+metadata_url="http://metadata.google.internal/computeMetadata/v1/instance/attributes"
+
+function metadata() {
+  curl --silent --fail --retry 10 --retry-delay 3 -H "Metadata-Flavor: Google" "${metadata_url}/$1"
+}
+
+function setup_local_ssds() {
+  local mount_path="$1"
+  local device
+  local devices=()
+
+  if mountpoint --quiet "${mount_path}"; then
+    echo "Local SSDs are already mounted at ${mount_path}"
+    return 0
+  fi
+
+  # Local NVMe SSDs are reported with the model nvme_card, while persistent disks attached via NVMe are not.
+  for model in /sys/block/nvme*/device/model; do
+    if [[ -e "${model}" && "$(tr -d '[:space:]' < "${model}")" == "nvme_card" ]]; then
+      devices+=("/dev/$(basename "$(dirname "$(dirname "${model}")")")")
+    fi
+  done
+
+  if [[ ${#devices[@]} -eq 0 ]]; then
+    echo "Could not find any local NVMe SSD"
+    return 1
+  fi
+
+  for device in "${devices[@]}"; do
+    # Local SSDs do not benefit from I/O scheduling and read-ahead.
+    echo none > "/sys/block/$(basename "${device}")/queue/scheduler"
+    echo 0 > "/sys/block/$(basename "${device}")/queue/read_ahead_kb"
+  done
+
+  device="${devices[0]}"
+  if [[ ${#devices[@]} -gt 1 ]]; then
+    device="/dev/md/gcp-local-ssd"
+    if [[ ! -e "${device}" ]]; then
+      echo "Combining ${#devices[@]} local SSDs into a RAID 0 array"
+      mdadm --assemble "${device}" "${devices[@]}" 2>/dev/null || \
+        mdadm --create "${device}" --run --name=gcp-local-ssd --level=0 --raid-devices=${#devices[@]} "${devices[@]}" || return 1
+    fi
+  fi
+
+  if ! blkid "${device}" > /dev/null; then
+    echo "Formatting ${device}"
+    mkfs.ext4 -F -E lazy_itable_init=0,lazy_journal_init=0,discard "${device}" || return 1
+  fi
+
+  echo "Mounting ${device} at ${mount_path}"
+  mkdir -p "${mount_path}"
+  mount -o discard,defaults "${device}" "${mount_path}"
+}
+
+function conceal_metadata_server() {
+  # Only packets of pods which do not use the host network pass the PREROUTING chain, the packets of the node itself
+  # and of pods using the host network pass the OUTPUT chain.
+  echo "Concealing the metadata server from pods"
+  iptables -w -t raw -C PREROUTING -d 169.254.169.254/32 -j DROP 2>/dev/null || \
+    iptables -w -t raw -I PREROUTING -d 169.254.169.254/32 -j DROP || return 1
+  if command -v ip6tables > /dev/null; then
+    ip6tables -w -t raw -C PREROUTING -d fd20:ce::254/128 -j DROP 2>/dev/null || \
+      ip6tables -w -t raw -I PREROUTING -d fd20:ce::254/128 -j DROP || return 1
+  fi
+}
+
+failed=0
+
+if mount_path="$(metadata "` + gcp.LocalSSDMountPathMetadataKey + `")"; then
+  setup_local_ssds "${mount_path}" || failed=1
+fi
+
+if [[ "$(metadata "` + gcp.ConcealMetadataServerMetadataKey + `")" == "true" ]]; then
+  conceal_metadata_server || failed=1
+fi
+
+exit ${failed}
+`
+
+	nodeBootstrapUnit = `[Unit]
+Description=Bootstrap GCP specific features of the node
+Wants=network-online.target
+After=network-online.target
+Before=containerd.service kubelet.service
+[Install]
+WantedBy=multi-user.target
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=` + nodeBootstrapScriptPath + `
+`
+)
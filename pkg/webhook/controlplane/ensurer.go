@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-gcp/imagevector"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
@@ -140,8 +141,19 @@ func (e *ensurer) EnsureKubeAPIServerDeployment(
 		return err
 	}
 
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(cluster)
+	if err != nil {
+		return err
+	}
+
 	if c := extensionswebhook.ContainerWithName(ps.Containers, "kube-apiserver"); c != nil {
 		ensureKubeAPIServerCommandLineArgs(c, k8sVersion)
+
+		if cpConfig.KMS != nil {
+			if err := e.ensureKMSEncryption(ctx, newDeployment.Namespace, cpConfig.KMS, template, c, k8sVersion); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
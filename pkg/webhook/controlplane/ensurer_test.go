@@ -17,6 +17,7 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/test"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	testutils "github.com/gardener/gardener/pkg/utils/test"
@@ -27,6 +28,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"k8s.io/utils/ptr"
@@ -206,6 +208,118 @@ var _ = Describe("Ensurer", func() {
 			Expect(err).To(Not(HaveOccurred()))
 			checkKubeAPIServerDeployment(dep, "1.26.0")
 		})
+
+		It("should deploy the KMS plugin and wire the encryption configuration", func() {
+			DeferCleanup(testutils.WithVar(&ImageVector, imagevectorutils.ImageVector{{
+				Name:       "gcp-kms-plugin",
+				Repository: ptr.To("foo"),
+				Tag:        ptr.To("bar"),
+			}}))
+
+			eContextKMS := gcontext.NewInternalGardenContext(
+				&extensionscontroller.Cluster{
+					Shoot: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Kubernetes: gardencorev1beta1.Kubernetes{
+								Version: "1.31.1",
+							},
+							Provider: gardencorev1beta1.Provider{
+								ControlPlaneConfig: &runtime.RawExtension{
+									Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","kms":{"keyName":"projects/foo/locations/europe/keyRings/bar/cryptoKeys/baz"}}`),
+								},
+							},
+						},
+					},
+				},
+			)
+
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace},
+				Data:       map[string][]byte{"serviceaccount.json": []byte(`{"type":"service_account","project_id":"foo"}`)},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-configuration-gcp-kms", Namespace: namespace},
+			})).To(Succeed())
+
+			dep.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{Name: "etcd-encryption-secret", MountPath: "/etc/kubernetes/etcd-encryption-secret"}}
+			dep.Spec.Template.Spec.Volumes = []corev1.Volume{{
+				Name:         "etcd-encryption-secret",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "kube-apiserver-etcd-encryption-configuration-abcd"}},
+			}}
+
+			Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextKMS, dep, nil)).To(Succeed())
+
+			ps := dep.Spec.Template.Spec
+			Expect(ps.Volumes).To(ContainElements(
+				corev1.Volume{
+					Name:         "etcd-encryption-secret",
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "kube-apiserver-etcd-encryption-configuration-gcp-kms"}},
+				},
+				corev1.Volume{
+					Name:         "gcp-kms-plugin-socket",
+					VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+				},
+			))
+			Expect(ps.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "gcp-kms-plugin-socket", MountPath: "/var/run/kmsplugin"}))
+
+			plugin := extensionswebhook.ContainerWithName(ps.Containers, "gcp-kms-plugin")
+			Expect(plugin).NotTo(BeNil())
+			Expect(plugin.Image).To(Equal("foo:bar"))
+			Expect(plugin.Args).To(ContainElement("--key-uri=projects/foo/locations/europe/keyRings/bar/cryptoKeys/baz"))
+
+			Expect(ps.Containers[0].Command).To(ContainElements("--feature-gates=KMSv1=true", "--encryption-provider-config-automatic-reload=true"))
+
+			By("mutating the deployment with the rotated encryption configuration")
+			dep.Spec.Template.Spec.Volumes[0].Secret.SecretName = "kube-apiserver-etcd-encryption-configuration-efgh"
+			Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextKMS, dep, nil)).To(Succeed())
+			Expect(dep.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name:         "etcd-encryption-secret",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "kube-apiserver-etcd-encryption-configuration-gcp-kms"}},
+			}))
+		})
+
+		It("should keep the encryption configuration managed by Gardener until the one with the KMS provider was rendered", func() {
+			DeferCleanup(testutils.WithVar(&ImageVector, imagevectorutils.ImageVector{{
+				Name:       "gcp-kms-plugin",
+				Repository: ptr.To("foo"),
+				Tag:        ptr.To("bar"),
+			}}))
+
+			eContextKMS := gcontext.NewInternalGardenContext(
+				&extensionscontroller.Cluster{
+					Shoot: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Kubernetes: gardencorev1beta1.Kubernetes{
+								Version: "1.31.1",
+							},
+							Provider: gardencorev1beta1.Provider{
+								ControlPlaneConfig: &runtime.RawExtension{
+									Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","kms":{"keyName":"projects/foo/locations/europe/keyRings/bar/cryptoKeys/baz"}}`),
+								},
+							},
+						},
+					},
+				},
+			)
+
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace},
+				Data:       map[string][]byte{"serviceaccount.json": []byte(`{"type":"service_account","project_id":"foo"}`)},
+			})).To(Succeed())
+
+			dep.Spec.Template.Spec.Volumes = []corev1.Volume{{
+				Name:         "etcd-encryption-secret",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "kube-apiserver-etcd-encryption-configuration-abcd"}},
+			}}
+
+			Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextKMS, dep, nil)).To(Succeed())
+			Expect(dep.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name:         "etcd-encryption-secret",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "kube-apiserver-etcd-encryption-configuration-abcd"}},
+			}))
+			Expect(dep.Spec.Template.Spec.Containers[0].Command).NotTo(ContainElement(HavePrefix("--encryption-provider-config-automatic-reload")))
+			Expect(extensionswebhook.ContainerWithName(dep.Spec.Template.Spec.Containers, "gcp-kms-plugin")).NotTo(BeNil())
+		})
 	})
 
 	Describe("#EnsureKubeControllerManagerDeployment", func() {
@@ -544,6 +658,120 @@ var _ = Describe("Ensurer", func() {
 		})
 	})
 
+	Describe("#EnsureAdditionalFiles and #EnsureAdditionalUnits", func() {
+		var (
+			ensurer genericmutator.Ensurer
+			files   []extensionsv1alpha1.File
+			units   []extensionsv1alpha1.Unit
+		)
+
+		BeforeEach(func() {
+			ensurer = NewEnsurer(fakeClient, logger)
+			files = []extensionsv1alpha1.File{{Path: "/foo"}}
+			units = []extensionsv1alpha1.Unit{{Name: "foo.service"}}
+		})
+
+		It("should not add the route configuration if no additional network interfaces are configured", func() {
+			Expect(ensurer.EnsureAdditionalFiles(ctx, eContextK8s131, &files, nil)).To(Succeed())
+			Expect(ensurer.EnsureAdditionalUnits(ctx, eContextK8s131, &units, nil)).To(Succeed())
+
+			Expect(files).To(Equal([]extensionsv1alpha1.File{{Path: "/foo"}}))
+			Expect(units).To(Equal([]extensionsv1alpha1.Unit{{Name: "foo.service"}}))
+		})
+
+		It("should add the route configuration if additional network interfaces are configured", func() {
+			eContextNICs := gcontext.NewInternalGardenContext(
+				&extensionscontroller.Cluster{
+					Shoot: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Provider: gardencorev1beta1.Provider{
+								Workers: []gardencorev1beta1.Worker{
+									{Name: "pool-1"},
+									{
+										Name: "pool-2",
+										ProviderConfig: &runtime.RawExtension{
+											Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","additionalNetworkInterfaces":[{"network":"foo","subnetwork":"bar","routes":["10.0.0.0/8"]}]}`),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			)
+
+			Expect(ensurer.EnsureAdditionalFiles(ctx, eContextNICs, &files, nil)).To(Succeed())
+			Expect(ensurer.EnsureAdditionalUnits(ctx, eContextNICs, &units, nil)).To(Succeed())
+
+			Expect(files).To(ConsistOf(
+				extensionsv1alpha1.File{Path: "/foo"},
+				extensionsv1alpha1.File{
+					Path:        "/opt/bin/gcp-additional-network-routes.sh",
+					Permissions: ptr.To[uint32](0755),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{Data: additionalNetworkRoutesScript},
+					},
+				},
+			))
+			Expect(units).To(ConsistOf(
+				extensionsv1alpha1.Unit{Name: "foo.service"},
+				extensionsv1alpha1.Unit{
+					Name:      "gcp-additional-network-routes.service",
+					Command:   ptr.To(extensionsv1alpha1.CommandStart),
+					Enable:    ptr.To(true),
+					Content:   ptr.To(additionalNetworkRoutesUnit),
+					FilePaths: []string{"/opt/bin/gcp-additional-network-routes.sh"},
+				},
+			))
+		})
+
+		It("should add the node bootstrap if any worker pool configures it", func() {
+			eContextBootstrap := gcontext.NewInternalGardenContext(
+				&extensionscontroller.Cluster{
+					Shoot: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Provider: gardencorev1beta1.Provider{
+								Workers: []gardencorev1beta1.Worker{
+									{Name: "pool-1"},
+									{
+										Name: "pool-2",
+										ProviderConfig: &runtime.RawExtension{
+											Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","nodeBootstrap":{"concealMetadataServer":true}}`),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			)
+
+			Expect(ensurer.EnsureAdditionalFiles(ctx, eContextBootstrap, &files, nil)).To(Succeed())
+			Expect(ensurer.EnsureAdditionalUnits(ctx, eContextBootstrap, &units, nil)).To(Succeed())
+
+			Expect(files).To(ConsistOf(
+				extensionsv1alpha1.File{Path: "/foo"},
+				extensionsv1alpha1.File{
+					Path:        "/opt/bin/gcp-node-bootstrap.sh",
+					Permissions: ptr.To[uint32](0755),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{Data: nodeBootstrapScript},
+					},
+				},
+			))
+			Expect(units).To(ConsistOf(
+				extensionsv1alpha1.Unit{Name: "foo.service"},
+				extensionsv1alpha1.Unit{
+					Name:      "gcp-node-bootstrap.service",
+					Command:   ptr.To(extensionsv1alpha1.CommandStart),
+					Enable:    ptr.To(true),
+					Content:   ptr.To(nodeBootstrapUnit),
+					FilePaths: []string{"/opt/bin/gcp-node-bootstrap.sh"},
+				},
+			))
+		})
+	})
+
 	Describe("#EnsureMachineControllerManagerDeployment", func() {
 		var (
 			deployment *appsv1.Deployment
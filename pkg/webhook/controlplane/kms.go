@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	kmsPluginContainerName   = "gcp-kms-plugin"
+	kmsSocketVolumeName      = "gcp-kms-plugin-socket"
+	kmsCredentialsVolumeName = "gcp-kms-plugin-credentials"
+	kmsCredentialsMountPath  = "/srv/cloudprovider"
+	kmsHealthzPort           = 8081
+
+	// etcdEncryptionVolumeName is the name of the volume containing the encryption configuration of the
+	// kube-apiserver which is managed by Gardener.
+	etcdEncryptionVolumeName = "etcd-encryption-secret"
+)
+
+// ensureKMSEncryption deploys the GCP KMS plugin as sidecar of the kube-apiserver and replaces the encryption
+// configuration managed by Gardener with the one rendered by the controlplane chart, which encrypts all resources with
+// the KMS provider first. The providers of the original configuration are kept so that existing data can still be
+// read.
+func (e *ensurer) ensureKMSEncryption(ctx context.Context, namespace string, kms *apisgcp.KMSConfig, template *corev1.PodTemplateSpec, c *corev1.Container, k8sVersion *semver.Version) error {
+	ps := &template.Spec
+
+	volume := encryptionVolume(template)
+	if volume == nil {
+		return nil
+	}
+
+	// The encryption configuration with the KMS provider is rendered by the controlplane chart from the current
+	// configuration managed by Gardener. Until the control plane was reconciled for the first time, the configuration
+	// managed by Gardener is kept. Afterwards, the secret is updated in place when the encryption key is rotated, hence
+	// the kube-apiserver reloads it automatically.
+	if err := e.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: gcp.KMSEncryptionSecretName}, &corev1.Secret{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+	} else {
+		volume.Secret.SecretName = gcp.KMSEncryptionSecretName
+		c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--encryption-provider-config-automatic-reload=", "true")
+	}
+
+	// The GCP KMS plugin only implements the v1 KMS API, which is disabled by default since Kubernetes 1.29.
+	if versionutils.ConstraintK8sGreaterEqual129.Check(k8sVersion) {
+		c.Command = extensionswebhook.EnsureStringWithPrefixContains(c.Command, "--feature-gates=", "KMSv1=true", ",")
+	}
+
+	credentialsConfig, err := gcp.GetCredentialsConfigFromSecretReference(ctx, e.client, corev1.SecretReference{Namespace: namespace, Name: v1beta1constants.SecretNameCloudProvider})
+	if err != nil {
+		return err
+	}
+
+	image, err := ImageVector.FindImage(gcp.KMSPluginImageName)
+	if err != nil {
+		return err
+	}
+
+	credentialsMountPath := kmsCredentialsMountPath
+	if useWorkloadIdentity(credentialsConfig) {
+		credentialsMountPath = gcp.WorkloadIdentityMountPath
+	}
+
+	socketMount := corev1.VolumeMount{Name: kmsSocketVolumeName, MountPath: gcp.KMSPluginSocketDir}
+	c.VolumeMounts = extensionswebhook.EnsureVolumeMountWithName(c.VolumeMounts, socketMount)
+
+	ps.Containers = extensionswebhook.EnsureContainerWithName(ps.Containers, corev1.Container{
+		Name:            kmsPluginContainerName,
+		Image:           image.String(),
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Args: []string{
+			"--logtostderr",
+			"--key-uri=" + kms.KeyName,
+			fmt.Sprintf("--path-to-unix-socket=%s/socket.sock", gcp.KMSPluginSocketDir),
+			fmt.Sprintf("--healthz-port=%d", kmsHealthzPort),
+			"--healthz-path=/healthz",
+		},
+		Env: []corev1.EnvVar{{
+			Name:  "GOOGLE_APPLICATION_CREDENTIALS",
+			Value: credentialsMountPath + "/" + gcp.CredentialsConfigField,
+		}},
+		LivenessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: "/healthz",
+					Port: intstr.FromInt32(kmsHealthzPort),
+				},
+			},
+			InitialDelaySeconds: 15,
+			PeriodSeconds:       10,
+			TimeoutSeconds:      5,
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			socketMount,
+			{Name: kmsCredentialsVolumeName, MountPath: credentialsMountPath, ReadOnly: true},
+		},
+	})
+
+	ps.Volumes = extensionswebhook.EnsureVolumeWithName(ps.Volumes, corev1.Volume{
+		Name:         kmsSocketVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	ps.Volumes = extensionswebhook.EnsureVolumeWithName(ps.Volumes, kmsCredentialsVolume(credentialsConfig))
+
+	return nil
+}
+
+func encryptionVolume(template *corev1.PodTemplateSpec) *corev1.Volume {
+	for i := range template.Spec.Volumes {
+		if volume := &template.Spec.Volumes[i]; volume.Name == etcdEncryptionVolumeName && volume.Secret != nil {
+			return volume
+		}
+	}
+	return nil
+}
+
+func kmsCredentialsVolume(credentialsConfig *gcp.CredentialsConfig) corev1.Volume {
+	items := []corev1.KeyToPath{{Key: gcp.ServiceAccountJSONField, Path: gcp.CredentialsConfigField}}
+	if useWorkloadIdentity(credentialsConfig) {
+		items = []corev1.KeyToPath{
+			{Key: gcp.CredentialsConfigField, Path: gcp.CredentialsConfigField},
+			{Key: "token", Path: "token"},
+		}
+	}
+
+	return corev1.Volume{
+		Name: kmsCredentialsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				DefaultMode: ptr.To[int32](420),
+				Sources: []corev1.VolumeProjection{{
+					Secret: &corev1.SecretProjection{
+						LocalObjectReference: corev1.LocalObjectReference{Name: v1beta1constants.SecretNameCloudProvider},
+						Items:                items,
+					},
+				}},
+			},
+		},
+	}
+}
+
+func useWorkloadIdentity(credentialsConfig *gcp.CredentialsConfig) bool {
+	return credentialsConfig.Type == gcp.ExternalAccountCredentialType && len(credentialsConfig.TokenFilePath) > 0
+}
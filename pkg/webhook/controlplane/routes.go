@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	additionalNetworkRoutesUnitName   = "gcp-additional-network-routes.service"
+	additionalNetworkRoutesScriptPath = "/opt/bin/gcp-additional-network-routes.sh"
+)
+
+var (
+	additionalNetworkRoutesScript = `#!/bin/bash
+set -o nounset
+set -o pipefail
+
+metadata_url="http://metadata.google.internal/computeMetadata/v1/instance"
+
+function metadata() {
+  curl --silent --fail --retry 10 --retry-delay 3 -H "Metadata-Flavor: Google" "${metadata_url}/$1"
+}
+
+if ! routes="$(metadata "attributes/` + gcp.AdditionalNetworkRoutesMetadataKey + `")"; then
+  echo "No routes configured for additional network interfaces"
+  exit 0
+fi
+
+while read -r nic cidr; do
+  if [[ -z "${nic}" || -z "${cidr}" ]]; then
+    continue
+  fi
+
+  mac="$(metadata "network-interfaces/${nic}/mac")"
+  gateway="$(metadata "network-interfaces/${nic}/gateway")"
+  device=""
+  for address in /sys/class/net/*/address; do
+    if [[ "$(cat "${address}")" == "${mac}" ]]; then
+      device="$(basename "$(dirname "${address}")")"
+      break
+    fi
+  done
+
+  if [[ -z "${device}" ]]; then
+    echo "Could not find device of network interface ${nic} with mac ${mac}"
+    exit 1
+  fi
+
+  echo "Routing ${cidr} via ${gateway} on ${device}"
+  ip route replace "${cidr}" via "${gateway}" dev "${device}"
+done <<< "${routes}"
+`
+
+	additionalNetworkRoutesUnit = `[Unit]
+Description=Configure routes for additional network interfaces
+Wants=network-online.target
+After=network-online.target
+[Install]
+WantedBy=multi-user.target
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=` + additionalNetworkRoutesScriptPath + `
+`
+)
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+)
+
+// nodeFeatures are the GCP specific features of the nodes which are configured by additional units. The per-pool
+// configuration of the features is passed to the machines via instance metadata, hence the same units can be used for
+// all worker pools.
+type nodeFeatures struct {
+	additionalNetworkRoutes bool
+	nodeBootstrap           bool
+}
+
+// EnsureAdditionalFiles ensures that additional required system files are added.
+func (e *ensurer) EnsureAdditionalFiles(ctx context.Context, gctx gcontext.GardenContext, newFiles, _ *[]extensionsv1alpha1.File) error {
+	features, err := configuredNodeFeatures(ctx, gctx)
+	if err != nil {
+		return err
+	}
+
+	if features.additionalNetworkRoutes {
+		*newFiles = extensionswebhook.EnsureFileWithPath(*newFiles, extensionsv1alpha1.File{
+			Path:        additionalNetworkRoutesScriptPath,
+			Permissions: ptr.To[uint32](0755),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Data: additionalNetworkRoutesScript,
+				},
+			},
+		})
+	}
+
+	if features.nodeBootstrap {
+		*newFiles = extensionswebhook.EnsureFileWithPath(*newFiles, extensionsv1alpha1.File{
+			Path:        nodeBootstrapScriptPath,
+			Permissions: ptr.To[uint32](0755),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Data: nodeBootstrapScript,
+				},
+			},
+		})
+	}
+	return nil
+}
+
+// EnsureAdditionalUnits ensures that additional required system units are added.
+func (e *ensurer) EnsureAdditionalUnits(ctx context.Context, gctx gcontext.GardenContext, newUnits, _ *[]extensionsv1alpha1.Unit) error {
+	features, err := configuredNodeFeatures(ctx, gctx)
+	if err != nil {
+		return err
+	}
+
+	if features.additionalNetworkRoutes {
+		*newUnits = extensionswebhook.EnsureUnitWithName(*newUnits, extensionsv1alpha1.Unit{
+			Name:      additionalNetworkRoutesUnitName,
+			Command:   ptr.To(extensionsv1alpha1.CommandStart),
+			Enable:    ptr.To(true),
+			Content:   ptr.To(additionalNetworkRoutesUnit),
+			FilePaths: []string{additionalNetworkRoutesScriptPath},
+		})
+	}
+
+	if features.nodeBootstrap {
+		*newUnits = extensionswebhook.EnsureUnitWithName(*newUnits, extensionsv1alpha1.Unit{
+			Name:      nodeBootstrapUnitName,
+			Command:   ptr.To(extensionsv1alpha1.CommandStart),
+			Enable:    ptr.To(true),
+			Content:   ptr.To(nodeBootstrapUnit),
+			FilePaths: []string{nodeBootstrapScriptPath},
+		})
+	}
+	return nil
+}
+
+// configuredNodeFeatures checks which GCP specific features of the nodes are configured by any worker pool of the shoot.
+func configuredNodeFeatures(ctx context.Context, gctx gcontext.GardenContext) (nodeFeatures, error) {
+	var features nodeFeatures
+
+	cluster, err := gctx.GetCluster(ctx)
+	if err != nil {
+		return features, err
+	}
+
+	for _, pool := range cluster.Shoot.Spec.Provider.Workers {
+		workerConfig, err := helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
+		if err != nil {
+			return features, fmt.Errorf("could not decode provider config of worker pool %q: %w", pool.Name, err)
+		}
+		if len(workerConfig.AdditionalNetworkInterfaces) > 0 {
+			features.additionalNetworkRoutes = true
+		}
+		if workerConfig.NodeBootstrap != nil {
+			features.nodeBootstrap = true
+		}
+	}
+	return features, nil
+}
@@ -7,10 +7,21 @@ package shoot
 import (
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/extensions/pkg/webhook/shoot"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	// ServiceWebhookName is the name of the webhook defaulting the load balancer settings of Services in the shoot.
+	ServiceWebhookName = "shoot-service"
+	// PersistentVolumeWebhookName is the name of the webhook adding the topology of in-tree PersistentVolumes in the
+	// shoot.
+	PersistentVolumeWebhookName = "shoot-persistentvolume"
 )
 
 var (
@@ -38,3 +49,46 @@ func AddToManagerWithOptions(mgr manager.Manager, _ AddOptions) (*extensionswebh
 func AddToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 	return AddToManagerWithOptions(mgr, DefaultAddOptions)
 }
+
+// AddServiceWebhookToManager creates a webhook defaulting the load balancer settings of Services in the shoot and
+// adds it to the manager. The mutator needs the shoot client to read the defaults, which is not supported by
+// extensionswebhook.New, hence the webhook is built with a handler with shoot client. It keeps the default failure
+// policy of shoot webhooks, so that Services can still be written in the shoot while the extension is unavailable.
+func AddServiceWebhookToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	logger.Info("Adding service webhook to manager")
+	types := []extensionswebhook.Type{{Obj: &corev1.Service{}}}
+
+	handler, err := extensionswebhook.NewHandlerWithShootClient(mgr, types, NewServiceMutator(), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensionswebhook.Webhook{
+		Name:    ServiceWebhookName,
+		Path:    ServiceWebhookName,
+		Target:  extensionswebhook.TargetShoot,
+		Types:   types,
+		Handler: handler,
+	}, nil
+}
+
+// AddPersistentVolumeWebhookToManager creates a webhook adding the topology of in-tree PersistentVolumes in the shoot
+// and adds it to the manager.
+func AddPersistentVolumeWebhookToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	logger.Info("Adding persistentvolume webhook to manager")
+	webhook, err := extensionswebhook.New(mgr, extensionswebhook.Args{
+		Provider: gcp.Type,
+		Name:     PersistentVolumeWebhookName,
+		Path:     PersistentVolumeWebhookName,
+		Target:   extensionswebhook.TargetShoot,
+		Mutators: map[extensionswebhook.Mutator][]extensionswebhook.Type{
+			NewPersistentVolumeMutator(): {{Obj: &corev1.PersistentVolume{}}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.FailurePolicy = ptr.To(admissionregistrationv1.Ignore)
+	return webhook, nil
+}
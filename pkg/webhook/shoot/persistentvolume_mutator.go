@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// regionalDiskZoneDelimiter separates the zones of regional disks in the zone label of in-tree PersistentVolumes.
+const regionalDiskZoneDelimiter = "__"
+
+type persistentVolumeMutator struct {
+	logger logr.Logger
+}
+
+// NewPersistentVolumeMutator creates a new Mutator that adds the topology of in-tree GCE PD PersistentVolumes in the
+// shoot cluster which is required by the CSI migration.
+func NewPersistentVolumeMutator() extensionswebhook.Mutator {
+	return &persistentVolumeMutator{
+		logger: log.Log.WithName("shoot-persistentvolume-mutator"),
+	}
+}
+
+// Mutate adds the GA topology labels to in-tree GCE PD PersistentVolumes which only carry the labels of the removed
+// PersistentVolumeLabel admission plugin. New PersistentVolumes without node affinity are restricted to the zones of
+// their disk. Existing PersistentVolumes are only mutated when they are updated.
+func (m *persistentVolumeMutator) Mutate(_ context.Context, newObj, oldObj client.Object) error {
+	pv, ok := newObj.(*corev1.PersistentVolume)
+	if !ok {
+		return fmt.Errorf("wrong object type %T", newObj)
+	}
+	// If the object does have a deletion timestamp then we don't want to mutate anything.
+	if pv.DeletionTimestamp != nil {
+		return nil
+	}
+	if pv.Spec.GCEPersistentDisk == nil {
+		return nil
+	}
+
+	zone := getLabel(pv.Labels, corev1.LabelTopologyZone, corev1.LabelFailureDomainBetaZone)
+	if len(zone) == 0 {
+		return nil
+	}
+	zones := strings.Split(zone, regionalDiskZoneDelimiter)
+
+	region := getLabel(pv.Labels, corev1.LabelTopologyRegion, corev1.LabelFailureDomainBetaRegion)
+	if len(region) == 0 {
+		region = regionFromZone(zones[0])
+	}
+
+	if pv.Labels[corev1.LabelTopologyZone] != zone || pv.Labels[corev1.LabelTopologyRegion] != region {
+		extensionswebhook.LogMutation(m.logger, "PersistentVolume", pv.Namespace, pv.Name)
+		metav1.SetMetaDataLabel(&pv.ObjectMeta, corev1.LabelTopologyZone, zone)
+		metav1.SetMetaDataLabel(&pv.ObjectMeta, corev1.LabelTopologyRegion, region)
+	}
+
+	// The node affinity of existing PersistentVolumes cannot be changed.
+	if oldObj != nil || pv.Spec.NodeAffinity != nil {
+		return nil
+	}
+
+	extensionswebhook.LogMutation(m.logger, "PersistentVolume", pv.Namespace, pv.Name)
+	pv.Spec.NodeAffinity = &corev1.VolumeNodeAffinity{
+		Required: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{
+					Key:      corev1.LabelTopologyZone,
+					Operator: corev1.NodeSelectorOpIn,
+					Values:   zones,
+				}},
+			}},
+		},
+	}
+	return nil
+}
+
+// getLabel returns the value of the first of the given keys which is set in labels.
+func getLabel(labels map[string]string, keys ...string) string {
+	for _, key := range keys {
+		if value := labels[key]; len(value) > 0 {
+			return value
+		}
+	}
+	return ""
+}
+
+// regionFromZone returns the region of the given zone, e.g. europe-west1 for europe-west1-b.
+func regionFromZone(zone string) string {
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		return zone[:i]
+	}
+	return zone
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot_test
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/shoot"
+)
+
+var _ = Describe("PersistentVolumeMutator", func() {
+	var (
+		ctx     = context.TODO()
+		mutator extensionswebhook.Mutator
+		pv      *corev1.PersistentVolume
+	)
+
+	BeforeEach(func() {
+		mutator = NewPersistentVolumeMutator()
+		pv = &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pv",
+				Labels: map[string]string{
+					corev1.LabelFailureDomainBetaZone:   "europe-west1-b",
+					corev1.LabelFailureDomainBetaRegion: "europe-west1",
+				},
+			},
+			Spec: corev1.PersistentVolumeSpec{
+				PersistentVolumeSource: corev1.PersistentVolumeSource{
+					GCEPersistentDisk: &corev1.GCEPersistentDiskVolumeSource{PDName: "disk"},
+				},
+			},
+		}
+	})
+
+	nodeAffinity := func(zones ...string) *corev1.VolumeNodeAffinity {
+		return &corev1.VolumeNodeAffinity{
+			Required: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{
+						Key:      corev1.LabelTopologyZone,
+						Operator: corev1.NodeSelectorOpIn,
+						Values:   zones,
+					}},
+				}},
+			},
+		}
+	}
+
+	It("should not mutate CSI persistent volumes", func() {
+		pv.Spec.PersistentVolumeSource = corev1.PersistentVolumeSource{
+			CSI: &corev1.CSIPersistentVolumeSource{Driver: "pd.csi.storage.gke.io", VolumeHandle: "disk"},
+		}
+
+		Expect(mutator.Mutate(ctx, pv, nil)).To(Succeed())
+		Expect(pv.Labels).To(HaveLen(2))
+		Expect(pv.Spec.NodeAffinity).To(BeNil())
+	})
+
+	It("should not mutate in-tree persistent volumes without zone", func() {
+		pv.Labels = nil
+
+		Expect(mutator.Mutate(ctx, pv, nil)).To(Succeed())
+		Expect(pv.Labels).To(BeEmpty())
+		Expect(pv.Spec.NodeAffinity).To(BeNil())
+	})
+
+	It("should add the topology labels and the node affinity to new in-tree persistent volumes", func() {
+		Expect(mutator.Mutate(ctx, pv, nil)).To(Succeed())
+		Expect(pv.Labels).To(Equal(map[string]string{
+			corev1.LabelFailureDomainBetaZone:   "europe-west1-b",
+			corev1.LabelFailureDomainBetaRegion: "europe-west1",
+			corev1.LabelTopologyZone:            "europe-west1-b",
+			corev1.LabelTopologyRegion:          "europe-west1",
+		}))
+		Expect(pv.Spec.NodeAffinity).To(Equal(nodeAffinity("europe-west1-b")))
+	})
+
+	It("should derive the region and the zones of regional disks", func() {
+		pv.Labels = map[string]string{corev1.LabelFailureDomainBetaZone: "europe-west1-b__europe-west1-c"}
+
+		Expect(mutator.Mutate(ctx, pv, nil)).To(Succeed())
+		Expect(pv.Labels).To(HaveKeyWithValue(corev1.LabelTopologyZone, "europe-west1-b__europe-west1-c"))
+		Expect(pv.Labels).To(HaveKeyWithValue(corev1.LabelTopologyRegion, "europe-west1"))
+		Expect(pv.Spec.NodeAffinity).To(Equal(nodeAffinity("europe-west1-b", "europe-west1-c")))
+	})
+
+	It("should only add the topology labels to existing in-tree persistent volumes", func() {
+		oldPV := pv.DeepCopy()
+
+		Expect(mutator.Mutate(ctx, pv, oldPV)).To(Succeed())
+		Expect(pv.Labels).To(HaveKeyWithValue(corev1.LabelTopologyZone, "europe-west1-b"))
+		Expect(pv.Labels).To(HaveKeyWithValue(corev1.LabelTopologyRegion, "europe-west1"))
+		Expect(pv.Spec.NodeAffinity).To(BeNil())
+	})
+
+	It("should not overwrite an existing node affinity", func() {
+		pv.Spec.NodeAffinity = nodeAffinity("europe-west1-d")
+
+		Expect(mutator.Mutate(ctx, pv, nil)).To(Succeed())
+		Expect(pv.Spec.NodeAffinity).To(Equal(nodeAffinity("europe-west1-d")))
+	})
+})
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+type serviceMutator struct {
+	logger logr.Logger
+}
+
+// NewServiceMutator creates a new Mutator that defaults the load balancer settings of Services in the shoot cluster.
+func NewServiceMutator() extensionswebhook.MutatorWithShootClient {
+	return &serviceMutator{
+		logger: log.Log.WithName("shoot-service-mutator"),
+	}
+}
+
+// Mutate defaults the load balancer annotations of Services of type LoadBalancer according to the
+// load balancer defaults configured for the shoot.
+func (m *serviceMutator) Mutate(ctx context.Context, newObj, oldObj client.Object, shootClient client.Client) error {
+	service, ok := newObj.(*corev1.Service)
+	if !ok {
+		return fmt.Errorf("wrong object type %T", newObj)
+	}
+	// If the object does have a deletion timestamp then we don't want to mutate anything.
+	if service.DeletionTimestamp != nil {
+		return nil
+	}
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer || service.Spec.LoadBalancerClass != nil {
+		return nil
+	}
+	// Only default new load balancers, existing ones must not be switched to a different type.
+	if oldService, ok := oldObj.(*corev1.Service); ok && oldService.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+
+	defaults := &corev1.ConfigMap{}
+	if err := shootClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceSystem, Name: gcp.LoadBalancerDefaultsConfigMapName}, defaults); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("could not read load balancer defaults: %w", err)
+	}
+
+	loadBalancerType, ok := service.Annotations[gcp.AnnotationLoadBalancerType]
+	if !ok {
+		loadBalancerType, ok = service.Annotations[gcp.AnnotationLegacyLoadBalancerType]
+	}
+	if !ok && defaults.Data["type"] == gcp.LoadBalancerTypeInternal {
+		extensionswebhook.LogMutation(m.logger, "Service", service.Namespace, service.Name)
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, gcp.AnnotationLoadBalancerType, gcp.LoadBalancerTypeInternal)
+		loadBalancerType = gcp.LoadBalancerTypeInternal
+	}
+
+	if sourceRanges := defaults.Data["sourceRanges"]; len(sourceRanges) > 0 && len(service.Spec.LoadBalancerSourceRanges) == 0 {
+		if _, ok := service.Annotations[corev1.AnnotationLoadBalancerSourceRangesKey]; !ok {
+			extensionswebhook.LogMutation(m.logger, "Service", service.Namespace, service.Name)
+			service.Spec.LoadBalancerSourceRanges = strings.Split(sourceRanges, ",")
+		}
+	}
+
+	if loadBalancerType != gcp.LoadBalancerTypeInternal {
+		if _, ok := service.Annotations[gcp.AnnotationNetworkTier]; !ok && len(defaults.Data["networkTier"]) > 0 {
+			extensionswebhook.LogMutation(m.logger, "Service", service.Namespace, service.Name)
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, gcp.AnnotationNetworkTier, defaults.Data["networkTier"])
+		}
+		return nil
+	}
+
+	if _, ok := service.Annotations[gcp.AnnotationInternalLoadBalancerAllowGlobalAccess]; ok {
+		return nil
+	}
+	if globalAccess, _ := strconv.ParseBool(defaults.Data["globalAccess"]); globalAccess {
+		extensionswebhook.LogMutation(m.logger, "Service", service.Namespace, service.Name)
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, gcp.AnnotationInternalLoadBalancerAllowGlobalAccess, "true")
+	}
+
+	return nil
+}
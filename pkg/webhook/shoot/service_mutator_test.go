@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot_test
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/shoot"
+)
+
+var _ = Describe("ServiceMutator", func() {
+	var (
+		ctx         = context.TODO()
+		mutator     extensionswebhook.MutatorWithShootClient
+		shootClient client.Client
+		service     *corev1.Service
+	)
+
+	BeforeEach(func() {
+		mutator = NewServiceMutator()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		}
+	})
+
+	createDefaults := func(data map[string]string) {
+		Expect(shootClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: gcp.LoadBalancerDefaultsConfigMapName, Namespace: metav1.NamespaceSystem},
+			Data:       data,
+		})).To(Succeed())
+	}
+
+	It("should not mutate the service if no defaults are configured", func() {
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Annotations).To(BeEmpty())
+	})
+
+	It("should default the service to an internal load balancer with global access", func() {
+		createDefaults(map[string]string{"type": "Internal", "globalAccess": "true"})
+
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Annotations).To(Equal(map[string]string{
+			gcp.AnnotationLoadBalancerType:                      "Internal",
+			gcp.AnnotationInternalLoadBalancerAllowGlobalAccess: "true",
+		}))
+	})
+
+	It("should keep the load balancer type configured on the service", func() {
+		createDefaults(map[string]string{"type": "Internal", "globalAccess": "true"})
+		service.Annotations = map[string]string{gcp.AnnotationLoadBalancerType: "External"}
+
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Annotations).To(Equal(map[string]string{gcp.AnnotationLoadBalancerType: "External"}))
+	})
+
+	It("should only default global access for explicitly internal load balancers", func() {
+		createDefaults(map[string]string{"type": "External", "globalAccess": "true"})
+		service.Annotations = map[string]string{gcp.AnnotationLoadBalancerType: "Internal"}
+
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Annotations).To(HaveKeyWithValue(gcp.AnnotationInternalLoadBalancerAllowGlobalAccess, "true"))
+	})
+
+	It("should default the network tier of external load balancers", func() {
+		createDefaults(map[string]string{"type": "External", "globalAccess": "false", "networkTier": "Standard"})
+
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Annotations).To(Equal(map[string]string{gcp.AnnotationNetworkTier: "Standard"}))
+	})
+
+	It("should not default the network tier of internal load balancers", func() {
+		createDefaults(map[string]string{"type": "External", "globalAccess": "false", "networkTier": "Standard"})
+		service.Annotations = map[string]string{gcp.AnnotationLoadBalancerType: "Internal"}
+
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Annotations).To(Equal(map[string]string{gcp.AnnotationLoadBalancerType: "Internal"}))
+	})
+
+	It("should default the source ranges", func() {
+		createDefaults(map[string]string{"type": "External", "globalAccess": "false", "sourceRanges": "10.0.0.0/8,192.168.0.0/16"})
+
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Spec.LoadBalancerSourceRanges).To(Equal([]string{"10.0.0.0/8", "192.168.0.0/16"}))
+	})
+
+	It("should keep the source ranges configured on the service", func() {
+		createDefaults(map[string]string{"type": "External", "globalAccess": "false", "sourceRanges": "10.0.0.0/8"})
+		service.Spec.LoadBalancerSourceRanges = []string{"172.16.0.0/12"}
+
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Spec.LoadBalancerSourceRanges).To(Equal([]string{"172.16.0.0/12"}))
+	})
+
+	It("should not mutate existing load balancers", func() {
+		createDefaults(map[string]string{"type": "Internal", "globalAccess": "false"})
+		oldService := service.DeepCopy()
+
+		Expect(mutator.Mutate(ctx, service, oldService, shootClient)).To(Succeed())
+		Expect(service.Annotations).To(BeEmpty())
+	})
+
+	It("should not mutate services of other types", func() {
+		createDefaults(map[string]string{"type": "Internal", "globalAccess": "false"})
+		service.Spec.Type = corev1.ServiceTypeClusterIP
+
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Annotations).To(BeEmpty())
+	})
+})